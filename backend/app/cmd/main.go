@@ -13,10 +13,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/gin-middleware"
 
+	"skilly/internal/domain/repository"
 	"skilly/internal/infrastructure/dependencies"
 	"skilly/internal/infrastructure/gen"
 	"skilly/internal/infrastructure/middleware"
+	"skilly/internal/infrastructure/realtime"
 	"skilly/internal/infrastructure/server"
+	"skilly/internal/infrastructure/utils"
 	"skilly/internal/infrastructure/workers"
 )
 
@@ -45,9 +48,9 @@ func setupRouter() *gin.Engine {
 	return r
 }
 
-func startServer(deps *dependencies.Dependencies) func(ctx context.Context) {
+func startServer(deps *dependencies.Dependencies, hub *realtime.Hub) func(ctx context.Context) {
 	router := setupRouter()
-	appServer := server.NewServer(deps)
+	appServer := server.NewServer(deps, hub)
 
 	gen.RegisterHandlers(router, appServer)
 
@@ -74,10 +77,35 @@ func startServer(deps *dependencies.Dependencies) func(ctx context.Context) {
 func main() {
 	deps := dependencies.MustNewDependencies()
 
+	// replicas can leave migrations to a separate run of cmd/migrate
+	if utils.GetEnv("MONGODB_MIGRATE_ON_STARTUP", "true") == "true" {
+		err := deps.Mongo.Migrate(context.Background(), repository.Migrations(), deps.Logger)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	err := repository.NewUserRepository(deps.Mongo, deps.Logger).EnsureIndexes(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	err = repository.NewConversationRepository(deps.Mongo, deps.Logger).EnsureIndexes(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
 	workerManager := workers.NewWorkerManager(deps)
 	workerManager.Start()
 
-	stopServer := startServer(deps)
+	hub := realtime.NewHub(deps)
+	hubCtx, stopHub := context.WithCancel(context.Background())
+	hubStopped := make(chan struct{})
+	go func() {
+		defer close(hubStopped)
+		hub.Run(hubCtx)
+	}()
+
+	stopServer := startServer(deps, hub)
 
 	// graceful shutdown
 	quit := make(chan os.Signal, 1)
@@ -87,9 +115,26 @@ func main() {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
-	
-	go stopServer(ctx)
-	go workerManager.Stop()
 
+	// event streams are closed first, the server waiting for requests to end and no longer tracking upgraded WebSockets
+	if err := hub.Shutdown(ctx); err != nil {
+		deps.Logger.Error("Failed to close event streams:", slog.Any("error", err))
+	}
+	stopServer(ctx)
+	stopHub()
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		workerManager.Stop()
+		<-hubStopped
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		deps.Logger.Error("Timed out waiting for workers to stop")
+	}
+
+	deps.Close(ctx)
 	deps.Logger.Info("Server exiting")
 }
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"skilly/internal/adapters/mongo"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/logging"
+)
+
+// migrate applies the pending database migrations and exits, for deployments not running them at server startup.
+func main() {
+	logger := logging.SetupLogger()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	client := mongo.MustConnect(ctx, mongo.LoadConfigFromEnv(), logger)
+	defer client.Disconnect(context.Background())
+
+	err := client.Migrate(ctx, repository.Migrations(), logger)
+	if err != nil {
+		logger.Error("failed to migrate database", slog.Any("error", err))
+		stop()
+		os.Exit(1)
+	}
+
+	logger.Info("database is up to date")
+}
@@ -2,10 +2,12 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	kafkalib "github.com/segmentio/kafka-go"
@@ -26,6 +28,9 @@ type Client struct {
 	config     Config
 	logger     *slog.Logger
 	brokerList []string
+
+	writersMu sync.Mutex
+	writers   map[string]*kafkalib.Writer // Long-lived writer per topic, so messages are written in the order produced.
 }
 
 // DefaultConfig provides sensible defaults for Kafka.
@@ -69,6 +74,7 @@ func NewClient(ctx context.Context, cfg Config, logger *slog.Logger) (*Client, e
 		config:     cfg,
 		logger:     logger,
 		brokerList: cfg.Brokers,
+		writers:    map[string]*kafkalib.Writer{},
 	}
 
 	attemptsLeft := 3
@@ -95,19 +101,29 @@ func MustNewClient(ctx context.Context, cfg Config, logger *slog.Logger) *Client
 	return client
 }
 
-// Disconnect for KafkaClient. Since writers/readers are typically managed
-// by the caller of Produce/Consume methods, this can be a no-op or clean up
-// any shared resources if the client were to manage them (e.g., a connection pool).
+// Disconnect closes the writers ProduceMessage keeps, flushing the messages still being written.
+// Readers are managed by the callers of the consumer methods.
 func (c *Client) Disconnect() error {
-	c.logger.Info("KafkaClient Disconnect called (typically no-op for this design)")
-	return nil // No specific resources held by KafkaClient itself in this design
+	c.writersMu.Lock()
+	defer c.writersMu.Unlock()
+
+	var errs []error
+	for topic, writer := range c.writers {
+		if err := writer.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close writer for topic %s: %w", topic, err))
+		}
+		delete(c.writers, topic)
+	}
+	c.logger.Info("KafkaClient disconnected")
+	return errors.Join(errs...)
 }
 
 // GetWriterConfig returns a base kafka.WriterConfig based on the client's configuration.
 func (c *Client) GetWriterConfig() kafkalib.WriterConfig {
 	wc := kafkalib.WriterConfig{
-		Brokers:  c.brokerList,
-		Balancer: &kafkalib.LeastBytes{},
+		Brokers: c.brokerList,
+		// messages with the same key go to the same partition, where consumers read them in order
+		Balancer: &kafkalib.Hash{},
 		// Configure other writer options as needed:
 		// BatchSize: 100,
 		// BatchTimeout: 10 * time.Millisecond,
@@ -137,23 +153,15 @@ func (c *Client) GetReaderConfig(topic, groupID string) kafkalib.ReaderConfig {
 	return rc
 }
 
-// ProduceMessage sends a single message to the specified topic.
-// For high-throughput, consider creating a kafka.Writer once and reusing it.
-// This method creates/closes a writer per call for simplicity.
+// ProduceMessage sends a single message to the specified topic, returning once it is written.
+// Messages are written through a writer kept per topic, so those produced one after the other with the same key are
+// consumed in that order.
 func (c *Client) ProduceMessage(ctx context.Context, topic string, key, value []byte) error {
 	if topic == "" {
 		topic = c.config.DefaultTopic
 	}
 
-	writerConfig := c.GetWriterConfig()
-	writerConfig.Topic = topic
-
-	writer := kafkalib.NewWriter(writerConfig)
-	defer func() {
-		if err := writer.Close(); err != nil {
-			c.logger.Error("Failed to close Kafka writer", slog.Any("error", err), slog.String("topic", topic))
-		}
-	}()
+	writer := c.writer(topic)
 
 	msg := kafkalib.Message{
 		Key:   key,
@@ -170,6 +178,22 @@ func (c *Client) ProduceMessage(ctx context.Context, topic string, key, value []
 	return nil
 }
 
+// writer returns the writer of the topic, creating it on first use.
+func (c *Client) writer(topic string) *kafkalib.Writer {
+	c.writersMu.Lock()
+	defer c.writersMu.Unlock()
+
+	writer, ok := c.writers[topic]
+	if !ok {
+		writerConfig := c.GetWriterConfig()
+		writerConfig.Topic = topic
+		writerConfig.BatchSize = 1 // Callers wait for their message to be written, which mustn't wait for a batch to fill up.
+		writer = kafkalib.NewWriter(writerConfig)
+		c.writers[topic] = writer
+	}
+	return writer
+}
+
 // NewConsumer creates and returns a new kafka.Reader for the given topic and groupID.
 // The caller is responsible for calling Close() on the returned reader.
 func (c *Client) NewConsumer(topic, groupID string) (*kafkalib.Reader, error) {
@@ -187,6 +211,61 @@ func (c *Client) NewConsumer(topic, groupID string) (*kafkalib.Reader, error) {
 	return reader, nil
 }
 
+// NewBroadcastConsumers creates a reader for each partition of the topic, outside of any consumer group, so that every
+// instance reading the topic gets all of its messages, starting from the ones produced after the readers are created.
+// Outside of groups, nothing is committed nor left behind once they are closed. Reads return as soon as a message is
+// available. Partitions added to the topic afterwards aren't read.
+// The caller is responsible for calling Close() on the returned readers.
+func (c *Client) NewBroadcastConsumers(ctx context.Context, topic string) ([]*kafkalib.Reader, error) {
+	partitions, err := c.readPartitions(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	readers := make([]*kafkalib.Reader, 0, len(partitions))
+	for _, partition := range partitions {
+		readerConfig := c.GetReaderConfig(topic, "")
+		readerConfig.GroupID = ""
+		readerConfig.Partition = partition.ID
+		readerConfig.MinBytes = 1
+		reader := kafkalib.NewReader(readerConfig)
+		if err := reader.SetOffset(kafkalib.LastOffset); err != nil {
+			reader.Close()
+			for _, created := range readers {
+				created.Close()
+			}
+			return nil, fmt.Errorf("failed to set offset of partition %d of topic %s: %w", partition.ID, topic, err)
+		}
+		readers = append(readers, reader)
+	}
+
+	c.logger.Info("Kafka broadcast consumers created", slog.String("topic", topic), slog.Int("partitions", len(readers)))
+	return readers, nil
+}
+
+// readPartitions looks up the partitions of the topic on the first broker that answers.
+func (c *Client) readPartitions(ctx context.Context, topic string) ([]kafkalib.Partition, error) {
+	var errs []error
+	for _, broker := range c.brokerList {
+		conn, err := kafkalib.DialContext(ctx, "tcp", broker)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		partitions, err := conn.ReadPartitions(topic)
+		conn.Close()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if len(partitions) == 0 {
+			return nil, fmt.Errorf("topic %s has no partitions", topic)
+		}
+		return partitions, nil
+	}
+	return nil, fmt.Errorf("failed to read partitions of topic %s: %w", topic, errors.Join(errs...))
+}
+
 // Ping (Example - kafka-go doesn't have a direct ping)
 // A more robust ping might involve trying to get cluster metadata or list topics.
 // This is a very basic check using a temporary writer.
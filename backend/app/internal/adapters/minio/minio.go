@@ -2,6 +2,7 @@ package minio
 
 import (
 	"context"
+	"io"
 	"log/slog"
 	"net/url"
 	"os"
@@ -134,6 +135,11 @@ func (c *Client) GetObject(ctx context.Context, key string) (s3.Object, error) {
 	return c.Client.GetObject(ctx, c.BucketName, key, miniolib.GetObjectOptions{})
 }
 
+func (c *Client) PutObject(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error {
+	_, err := c.Client.PutObject(ctx, c.BucketName, key, reader, size, miniolib.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
 func (c *Client) RemoveObject(ctx context.Context, key string) error {
 	return c.Client.RemoveObject(ctx, c.BucketName, key, miniolib.RemoveObjectOptions{})
 }
@@ -0,0 +1,195 @@
+package mongo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	libmongo "go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	migrationsCollectionName     = "migrations"
+	migrationLocksCollectionName = "migration_locks"
+	migrationLockId              = "migrations"
+	migrationLockTTL             = 5 * time.Minute // A lock not refreshed for this long is left over by a crashed replica.
+	migrationLockRefreshInterval = time.Minute
+	migrationLockPollInterval    = time.Second
+)
+
+/*
+Migration is a versioned change of the database, e.g. creating indexes or backfilling documents.
+Up must be idempotent: a migration interrupted midway is run again from the start.
+*/
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, c *Client) error
+}
+
+type migrationRecord struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+}
+
+type migrationLock struct {
+	Id        string    `bson:"_id"`
+	Owner     string    `bson:"owner"`
+	ExpiresAt time.Time `bson:"expires_at"`
+}
+
+/*
+Migrate applies the migrations not applied yet, in order of version, and records them in the migrations collection.
+It holds a lock in the meantime so replicas starting together don't race, waiting for it as long as ctx allows. The
+lock is refreshed in the background however long a migration takes, and migrations are aborted if it is lost.
+*/
+func (c *Client) Migrate(ctx context.Context, migrations []Migration, logger *slog.Logger) error {
+	for i, migration := range migrations {
+		if migration.Version <= 0 || (i > 0 && migration.Version <= migrations[i-1].Version) {
+			return fmt.Errorf("migration versions must be positive and increasing, got %d", migration.Version)
+		}
+	}
+
+	owner, err := c.acquireMigrationLock(ctx, logger)
+	if err != nil {
+		return err
+	}
+	defer c.releaseMigrationLock(owner, logger)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	go c.keepMigrationLock(ctx, owner, cancel)
+
+	cur, err := c.Database.Collection(migrationsCollectionName).Find(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("failed to list applied migrations: %w", err)
+	}
+	var records []migrationRecord
+	if err := cur.All(ctx, &records); err != nil {
+		return fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	applied := make(map[int]bool, len(records))
+	for _, record := range records {
+		applied[record.Version] = true
+	}
+
+	for _, migration := range migrations {
+		if applied[migration.Version] {
+			continue
+		}
+
+		logger.Info("applying migration", slog.Int("version", migration.Version), slog.String("description", migration.Description))
+		start := time.Now()
+		if err := migration.Up(ctx, c); err != nil {
+			if cause := context.Cause(ctx); cause != nil {
+				err = cause
+			}
+			return fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Description, err)
+		}
+		// a migration ignoring ctx may finish after the lock was lost, and must not be recorded then
+		if cause := context.Cause(ctx); cause != nil {
+			return fmt.Errorf("migration %d (%s) aborted: %w", migration.Version, migration.Description, cause)
+		}
+
+		_, err := c.Database.Collection(migrationsCollectionName).InsertOne(ctx, migrationRecord{
+			Version:     migration.Version,
+			Description: migration.Description,
+			AppliedAt:   time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+		}
+		logger.Info("migration applied", slog.Int("version", migration.Version), slog.Duration("took", time.Since(start)))
+	}
+
+	return nil
+}
+
+// acquireMigrationLock waits until the lock is free or expired and takes it, returning the identifier it is held with.
+func (c *Client) acquireMigrationLock(ctx context.Context, logger *slog.Logger) (string, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate migration lock owner: %w", err)
+	}
+	owner := hex.EncodeToString(id)
+	locks := c.Database.Collection(migrationLocksCollectionName)
+
+	for {
+		now := time.Now()
+		_, err := locks.InsertOne(ctx, migrationLock{Id: migrationLockId, Owner: owner, ExpiresAt: now.Add(migrationLockTTL)})
+		if err == nil {
+			return owner, nil
+		}
+		if !libmongo.IsDuplicateKeyError(err) {
+			return "", fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+
+		res, err := locks.UpdateOne(ctx,
+			bson.M{"_id": migrationLockId, "expires_at": bson.M{"$lt": now}},
+			bson.M{"$set": bson.M{"owner": owner, "expires_at": now.Add(migrationLockTTL)}},
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to take over migration lock: %w", err)
+		}
+		if res.ModifiedCount > 0 {
+			logger.Warn("took over expired migration lock")
+			return owner, nil
+		}
+
+		logger.Info("waiting for migration lock")
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("failed to acquire migration lock: %w", ctx.Err())
+		case <-time.After(migrationLockPollInterval):
+		}
+	}
+}
+
+// keepMigrationLock refreshes the lock until ctx is done, canceling it with the reason if the lock can't be kept.
+func (c *Client) keepMigrationLock(ctx context.Context, owner string, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(migrationLockRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.refreshMigrationLock(ctx, owner); err != nil {
+				cancel(err)
+				return
+			}
+		}
+	}
+}
+
+func (c *Client) refreshMigrationLock(ctx context.Context, owner string) error {
+	res, err := c.Database.Collection(migrationLocksCollectionName).UpdateOne(ctx,
+		bson.M{"_id": migrationLockId, "owner": owner},
+		bson.M{"$set": bson.M{"expires_at": time.Now().Add(migrationLockTTL)}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to refresh migration lock: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("migration lock was lost")
+	}
+	return nil
+}
+
+// releaseMigrationLock releases the lock even if ctx is already canceled, so others don't have to wait for it to expire.
+func (c *Client) releaseMigrationLock(owner string, logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := c.Database.Collection(migrationLocksCollectionName).DeleteOne(ctx, bson.M{"_id": migrationLockId, "owner": owner})
+	if err != nil {
+		logger.Error("failed to release migration lock", slog.Any("error", err))
+	}
+}
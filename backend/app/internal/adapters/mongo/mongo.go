@@ -25,6 +25,7 @@ type Config struct {
 	MaxConnIdleTime      time.Duration
 	RetryConnectAttempts int
 	RetryConnectDelay    time.Duration
+	TextSearchLanguage   string // Language used for stemming and stop words by text indexes
 }
 
 // Client is a wrapper around the official mongo.Client and its Config.
@@ -47,6 +48,7 @@ func DefaultConfig() Config {
 		MaxConnIdleTime:      10 * time.Minute, // Default in driver
 		RetryConnectAttempts: 3,
 		RetryConnectDelay:    2 * time.Second,
+		TextSearchLanguage:   "english",
 	}
 }
 
@@ -95,6 +97,9 @@ func LoadConfigFromEnv() Config {
 			cfg.RetryConnectDelay = time.Duration(val) * time.Second
 		}
 	}
+	if language := os.Getenv("MONGODB_TEXT_SEARCH_LANGUAGE"); language != "" {
+		cfg.TextSearchLanguage = language
+	}
 	return cfg
 }
 
@@ -168,6 +173,11 @@ func MustConnect(ctx context.Context, cfg Config, logger *slog.Logger) *Client {
 	return client
 }
 
+// TextSearchLanguage returns the language text indexes should be created with.
+func (c *Client) TextSearchLanguage() string {
+	return c.config.TextSearchLanguage
+}
+
 // Disconnect gracefully closes the MongoDB client connection.
 func (c *Client) Disconnect(ctx context.Context) error {
 	if c.Client == nil {
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"context"
+	"io"
 	"log/slog"
 	"net/url"
 	"time"
@@ -15,6 +16,7 @@ type Client interface {
 	GenerateDownloadUrl(ctx context.Context, key string, expires time.Duration) (url.URL, error)
 	GenerateUploadUrl(ctx context.Context, key string, expires time.Duration) (url.URL, error)
 	GetObject(ctx context.Context, key string) (s3.Object, error)
+	PutObject(ctx context.Context, key string, reader io.Reader, size int64, contentType string) error
 	RemoveObject(ctx context.Context, key string) error
 	GetBucketName() string
 	Disconnect() error
@@ -0,0 +1,21 @@
+package events
+
+import (
+	"time"
+)
+
+const (
+	DeadLettersTopic = "dead-letters"
+)
+
+// DeadLetter is a message a worker kept failing to handle, parked to be looked into and replayed by hand.
+type DeadLetter struct {
+	Topic     string    `json:"topic"`
+	Partition int       `json:"partition"`
+	Offset    int64     `json:"offset"`
+	GroupId   string    `json:"group_id"` // Of the worker that failed to handle it.
+	Key       []byte    `json:"key"`
+	Value     []byte    `json:"value"`
+	Error     string    `json:"error"` // The last one the worker failed with.
+	FailedAt  time.Time `json:"failed_at"`
+}
@@ -0,0 +1,25 @@
+package events
+
+import (
+	"time"
+)
+
+const (
+	MessagesTopic = "message-events"
+)
+
+type MessageChangeKind string
+
+const (
+	MessageSent   MessageChangeKind = "sent"
+	MessageEdited MessageChangeKind = "edited"
+)
+
+// MessageChanged is published whenever the text of a message is set, for it to be moderated.
+type MessageChanged struct {
+	ConversationId string            `json:"conversation_id"`
+	MessageId      string            `json:"message_id"`
+	Sender         string            `json:"sender"`
+	Kind           MessageChangeKind `json:"kind"`
+	ChangedAt      time.Time         `json:"changed_at"`
+}
@@ -0,0 +1,23 @@
+package events
+
+import (
+	"time"
+)
+
+const (
+	NotificationsTopic = "notifications"
+)
+
+type NotificationKind string
+
+const (
+	NotificationSearchAlert NotificationKind = "search_alert"
+)
+
+// Notification is published for delivery to the recipient by email or push, and to their real-time connections.
+type Notification struct {
+	Recipient string            `json:"recipient"`
+	Kind      NotificationKind  `json:"kind"`
+	Data      map[string]string `json:"data"`
+	CreatedAt time.Time         `json:"created_at"`
+}
@@ -0,0 +1,17 @@
+package events
+
+import (
+	"time"
+)
+
+const (
+	ProfileViewsTopic = "profile-views"
+)
+
+// ProfileViewed is published every time a user views another user's profile.
+type ProfileViewed struct {
+	Id       string    `json:"id"` // Tells the view apart from deliveries of the same event.
+	Viewer   string    `json:"viewer"`
+	Owner    string    `json:"owner"`
+	ViewedAt time.Time `json:"viewed_at"`
+}
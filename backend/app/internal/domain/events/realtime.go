@@ -0,0 +1,19 @@
+package events
+
+import (
+	"encoding/json"
+)
+
+const (
+	RealtimeTopic = "realtime-events"
+)
+
+/*
+RealtimeEvent is published whenever something the recipients should hear about right away happens, e.g. a message being
+sent to them, to be pushed to their connections on every instance of the app.
+*/
+type RealtimeEvent struct {
+	Recipients []string        `json:"recipients"`
+	Payload    json.RawMessage `json:"payload"`             // Event as pushed to the recipients.
+	Ephemeral  bool            `json:"ephemeral,omitempty"` // Only pushed to the connections open at the time, never resumed.
+}
@@ -0,0 +1,23 @@
+package events
+
+import (
+	"time"
+)
+
+const (
+	UsersTopic = "user-events"
+)
+
+type UserChangeKind string
+
+const (
+	UserRegistered    UserChangeKind = "registered"
+	UserProfileEdited UserChangeKind = "profile_edited"
+)
+
+// UserChanged is published whenever a user's searchable profile may have changed.
+type UserChanged struct {
+	Username  string         `json:"username"`
+	Kind      UserChangeKind `json:"kind"`
+	ChangedAt time.Time      `json:"changed_at"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AllowedSender means Owner lets Username message them without going through a message request.
+type AllowedSender struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"`
+	Owner     string             `bson:"owner" json:"owner"`
+	Username  string             `bson:"username" json:"username"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
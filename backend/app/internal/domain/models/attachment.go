@@ -0,0 +1,69 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AttachmentStatus string
+
+const (
+	AttachmentStatusPending  AttachmentStatus = "pending"  // Waiting for the file to be uploaded and checked.
+	AttachmentStatusReady    AttachmentStatus = "ready"    // Checked, can be sent and downloaded.
+	AttachmentStatusRejected AttachmentStatus = "rejected" // Too large or of an unsupported type, its file deleted.
+)
+
+const (
+	attachmentKeyPrefix = "chat/"
+	previewKeyPrefix    = "chat-previews/" // Outside of attachmentKeyPrefix, so previews aren't checked as uploads.
+)
+
+// Attachment is a file uploaded to a conversation by Uploader, until it is sent in the message with MessageId.
+type Attachment struct {
+	Id             primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	ConversationId primitive.ObjectID  `bson:"conversation_id" json:"conversation_id"`
+	Uploader       string              `bson:"uploader" json:"uploader"`
+	Filename       string              `bson:"filename" json:"filename"`
+	Status         AttachmentStatus    `bson:"status" json:"status"`
+	ContentType    string              `bson:"content_type,omitempty" json:"content_type,omitempty"` // Detected once checked.
+	Size           int64               `bson:"size,omitempty" json:"size,omitempty"`                 // Known once checked.
+	HasPreview     bool                `bson:"has_preview" json:"has_preview"`
+	MessageId      *primitive.ObjectID `bson:"message_id,omitempty" json:"message_id,omitempty"`
+	CreatedAt      time.Time           `bson:"created_at" json:"created_at"`
+}
+
+// Key is where the file is stored, under a prefix per conversation.
+func (a Attachment) Key() string {
+	return fmt.Sprintf("%s%s/%s", attachmentKeyPrefix, a.ConversationId.Hex(), a.Id.Hex())
+}
+
+// PreviewKey is where the preview of an image is stored, as JPEG.
+func (a Attachment) PreviewKey() string {
+	return fmt.Sprintf("%s%s/%s.jpg", previewKeyPrefix, a.ConversationId.Hex(), a.Id.Hex())
+}
+
+// ParseAttachmentKey returns the conversation and attachment ids of the key of a file, if it is one of an attachment.
+func ParseAttachmentKey(key string) (primitive.ObjectID, primitive.ObjectID, bool) {
+	rest, ok := strings.CutPrefix(key, attachmentKeyPrefix)
+	if !ok {
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+	conversation, attachment, ok := strings.Cut(rest, "/")
+	if !ok {
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+
+	conversationId, err := primitive.ObjectIDFromHex(conversation)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+	attachmentId, err := primitive.ObjectIDFromHex(attachment)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+
+	return conversationId, attachmentId, true
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Block means Blocker no longer wants to interact with Blocked. It is enforced in both directions.
+type Block struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"`
+	Blocker   string             `bson:"blocker" json:"blocker"`
+	Blocked   string             `bson:"blocked" json:"blocked"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ConversationKind string
+
+const (
+	ConversationKindDirect ConversationKind = "direct" // Between two users, Participants holding their usernames sorted.
+	ConversationKindGroup  ConversationKind = "group"  // Between the members of Group, in the order they joined.
+)
+
+// Conversation is a one-to-one chat, or a group chat.
+type Conversation struct {
+	Id           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Kind         ConversationKind   `bson:"kind" json:"kind"`
+	Participants []string           `bson:"participants" json:"participants"`
+	Group        *Group             `bson:"group,omitempty" json:"group,omitempty"`     // Only set for groups.
+	Request      *MessageRequest    `bson:"request,omitempty" json:"request,omitempty"` // Only set for direct conversations started as a request.
+	LastMessage  *Message           `bson:"last_message,omitempty" json:"last_message,omitempty"`
+	MessageCount int64              `bson:"message_count" json:"message_count"` // Seq of the last message.
+	Reads        []ReadCursor       `bson:"reads" json:"reads"`                 // One per participant.
+	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at" json:"updated_at"` // When the last message was sent, CreatedAt until then.
+}
+
+type MessageRequestStatus string
+
+const (
+	MessageRequestPending  MessageRequestStatus = "pending"  // Waiting for the recipient, the sender only sending a few messages meanwhile.
+	MessageRequestAccepted MessageRequestStatus = "accepted" // The conversation goes on like any other.
+	MessageRequestDeclined MessageRequestStatus = "declined" // Hidden from the recipient, still pending as far as the sender can tell.
+)
+
+/*
+MessageRequest gates a direct conversation From started with To, who didn't allow them to message them, until To
+accepts it. The conversation stays out of To's conversations meanwhile, showing in their requests instead.
+*/
+type MessageRequest struct {
+	From      string               `bson:"from" json:"from"`
+	To        string               `bson:"to" json:"to"`
+	Status    MessageRequestStatus `bson:"status" json:"status"`
+	CreatedAt time.Time            `bson:"created_at" json:"created_at"`
+	DecidedAt *time.Time           `bson:"decided_at,omitempty" json:"decided_at,omitempty"`
+}
+
+/*
+ReadCursor is the last message a participant has read, along with the ones before it. Messages a participant sends
+count as read by them.
+*/
+type ReadCursor struct {
+	Username  string             `bson:"username" json:"username"`
+	Seq       int64              `bson:"seq" json:"seq"` // 0 until a message is read.
+	MessageId primitive.ObjectID `bson:"message_id,omitempty" json:"message_id"`
+	At        time.Time          `bson:"at,omitempty" json:"at"`
+}
+
+// Other returns the participant of a direct conversation other than username.
+func (c Conversation) Other(username string) string {
+	if c.Participants[0] == username {
+		return c.Participants[1]
+	}
+	return c.Participants[0]
+}
+
+// Accepted returns whether the conversation is open to all its participants, i.e. it isn't a request waiting for them.
+func (c Conversation) Accepted() bool {
+	return c.Request == nil || c.Request.Status == MessageRequestAccepted
+}
+
+/*
+Listeners returns the participants who hear about what happens in the conversation as it happens, leaving out the
+recipient of a request they haven't accepted.
+*/
+func (c Conversation) Listeners() []string {
+	if c.Accepted() {
+		return c.Participants
+	}
+	return []string{c.Request.From}
+}
+
+// ReadCursor returns the read cursor of the participant, empty if they have read nothing.
+func (c Conversation) ReadCursor(username string) ReadCursor {
+	for _, read := range c.Reads {
+		if read.Username == username {
+			return read
+		}
+	}
+	return ReadCursor{Username: username}
+}
+
+// UnreadCount returns the number of messages the participant hasn't read.
+func (c Conversation) UnreadCount(username string) int64 {
+	return c.MessageCount - c.ReadCursor(username).Seq
+}
+
+// Message is a message sent by Sender in a conversation.
+type Message struct {
+	Id             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	ConversationId primitive.ObjectID `bson:"conversation_id" json:"conversation_id"`
+	Seq            int64              `bson:"seq" json:"seq"` // Position of the message in the conversation, from 1.
+	Sender         string             `bson:"sender" json:"sender"`
+	Text           string             `bson:"text" json:"text"`
+	Attachments    []Attachment       `bson:"attachments,omitempty" json:"attachments,omitempty"` // As they were when sent.
+	HiddenFor      []string           `bson:"hidden_for,omitempty" json:"hidden_for,omitempty"`   // Participants who deleted the message for themselves.
+	EditedAt       *time.Time         `bson:"edited_at,omitempty" json:"edited_at,omitempty"`
+	DeletedAt      *time.Time         `bson:"deleted_at,omitempty" json:"deleted_at,omitempty"` // Once deleted for everyone, leaving a tombstone.
+	Restricted     bool               `bson:"restricted,omitempty" json:"-"`                    // Hidden by moderation from everyone but its sender, who isn't told.
+	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// HiddenFrom returns whether the participant deleted the message for themselves, or moderation hides it from them.
+func (m Message) HiddenFrom(username string) bool {
+	return slices.Contains(m.HiddenFor, username) || (m.Restricted && m.Sender != username)
+}
+
+// Recipients returns who hears about the message among the listeners of its conversation.
+func (m Message) Recipients(listeners []string) []string {
+	if m.Restricted {
+		return []string{m.Sender}
+	}
+	return listeners
+}
+
+type MessageRevisionKind string
+
+const (
+	MessageRevisionEdit   MessageRevisionKind = "edit"   // The sender edited the message.
+	MessageRevisionDelete MessageRevisionKind = "delete" // The sender deleted the message for everyone.
+)
+
+// MessageRevision is the content a message had before being edited or deleted, kept for moderators.
+type MessageRevision struct {
+	Id          primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	MessageId   primitive.ObjectID  `bson:"message_id" json:"message_id"`
+	Kind        MessageRevisionKind `bson:"kind" json:"kind"`
+	Text        string              `bson:"text" json:"text"`
+	Attachments []Attachment        `bson:"attachments,omitempty" json:"attachments,omitempty"`
+	RevisedAt   time.Time           `bson:"revised_at" json:"revised_at"`
+}
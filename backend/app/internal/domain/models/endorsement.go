@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Endorsement means Endorser vouches for Endorsee teaching Skill. There is at most one per pair per skill.
+type Endorsement struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"`
+	Endorser  string             `bson:"endorser" json:"endorser"`
+	Endorsee  string             `bson:"endorsee" json:"endorsee"`
+	Skill     string             `bson:"skill" json:"skill"`
+	Comment   string             `bson:"comment" json:"comment"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// SkillEndorsements is the number of endorsements of a single skill, kept on the user for profiles and sorting.
+type SkillEndorsements struct {
+	Skill string `bson:"skill" json:"skill"`
+	Count int64  `bson:"count" json:"count"`
+}
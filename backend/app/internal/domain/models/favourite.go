@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Favourite is a user saved by Owner. Note and Tags are private to the owner.
+type Favourite struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"`
+	Owner     string             `bson:"owner" json:"owner"`
+	Username  string             `bson:"username" json:"username"`
+	Note      string             `bson:"note" json:"note"`
+	Tags      []string           `bson:"tags" json:"tags"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+)
+
+type GroupRole string
+
+const (
+	GroupRoleOwner  GroupRole = "owner"  // Created the group or was handed it over, the only one who can change roles.
+	GroupRoleAdmin  GroupRole = "admin"  // Manages the settings, invites and members of the group.
+	GroupRoleMember GroupRole = "member" // Takes part in the conversation.
+)
+
+/*
+Group is a named conversation between its members about a skill. Public groups, known as circles, can be found by
+skill and joined by anyone, while others can only be joined with an invite.
+*/
+type Group struct {
+	Name        string        `bson:"name" json:"name"`
+	Skill       string        `bson:"skill" json:"skill"`
+	Description string        `bson:"description" json:"description"`
+	Public      bool          `bson:"public" json:"public"`
+	MemberLimit int           `bson:"member_limit" json:"member_limit"`
+	Members     []GroupMember `bson:"members" json:"members"` // Same users as the conversation's participants.
+	Invites     []GroupInvite `bson:"invites" json:"-"`
+}
+
+type GroupMember struct {
+	Username string    `bson:"username" json:"username"`
+	Role     GroupRole `bson:"role" json:"role"`
+	JoinedAt time.Time `bson:"joined_at" json:"joined_at"`
+}
+
+// GroupInvite lets whoever has its code join the group until it expires.
+type GroupInvite struct {
+	Code      string    `bson:"code" json:"code"`
+	CreatedBy string    `bson:"created_by" json:"created_by"`
+	ExpiresAt time.Time `bson:"expires_at" json:"expires_at"`
+}
+
+// Member returns the member of the group with the username, if any.
+func (g Group) Member(username string) (GroupMember, bool) {
+	for _, member := range g.Members {
+		if member.Username == username {
+			return member, true
+		}
+	}
+	return GroupMember{}, false
+}
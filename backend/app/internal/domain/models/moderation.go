@@ -0,0 +1,84 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ModerationSubjectKind string
+
+const (
+	ModerationSubjectMessage ModerationSubjectKind = "message"
+	ModerationSubjectBio     ModerationSubjectKind = "bio"
+)
+
+type ModerationRuleKind string
+
+const (
+	ModerationRuleWord      ModerationRuleKind = "word"      // Pattern is a word or phrase, matched whole and ignoring case.
+	ModerationRuleRegex     ModerationRuleKind = "regex"     // Pattern is a regular expression, in RE2 syntax.
+	ModerationRuleLink      ModerationRuleKind = "link"      // Links and bare domains.
+	ModerationRuleEmail     ModerationRuleKind = "email"     // Email addresses.
+	ModerationRulePhone     ModerationRuleKind = "phone"     // Phone numbers.
+	ModerationRuleRate      ModerationRuleKind = "rate"      // More than Limit messages sent within Window.
+	ModerationRuleDuplicate ModerationRuleKind = "duplicate" // The same text sent to more than Limit conversations within Window.
+)
+
+type ModerationAction string
+
+const (
+	ModerationActionFlag           ModerationAction = "flag"            // Only recorded for moderators.
+	ModerationActionHide           ModerationAction = "hide"            // The message or bio is hidden from everyone but its author.
+	ModerationActionShadowRestrict ModerationAction = "shadow_restrict" // Hides it, and everything the author sends from then on.
+)
+
+// Severity orders the actions, the strongest one found being taken.
+func (a ModerationAction) Severity() int {
+	return slices.Index([]ModerationAction{ModerationActionFlag, ModerationActionHide, ModerationActionShadowRestrict}, a)
+}
+
+// ModerationRule is a rule of the moderation pipeline, managed in the database and picked up without restarting.
+type ModerationRule struct {
+	Id        primitive.ObjectID      `bson:"_id,omitempty" json:"id"`
+	Kind      ModerationRuleKind      `bson:"kind" json:"kind"`
+	Pattern   string                  `bson:"pattern,omitempty" json:"pattern,omitempty"`               // For word and regex rules.
+	Limit     int64                   `bson:"limit,omitempty" json:"limit,omitempty"`                   // For rate and duplicate rules.
+	Window    int64                   `bson:"window_seconds,omitempty" json:"window_seconds,omitempty"` // For rate and duplicate rules.
+	Subjects  []ModerationSubjectKind `bson:"subjects,omitempty" json:"subjects,omitempty"`             // What the rule applies to, everything if empty.
+	Action    ModerationAction        `bson:"action" json:"action"`
+	Enabled   bool                    `bson:"enabled" json:"enabled"`
+	CreatedAt time.Time               `bson:"created_at" json:"created_at"`
+}
+
+func (r ModerationRule) AppliesTo(kind ModerationSubjectKind) bool {
+	return len(r.Subjects) == 0 || slices.Contains(r.Subjects, kind)
+}
+
+// ModerationSubject is what was moderated: a message, or the bio of Username.
+type ModerationSubject struct {
+	Kind           ModerationSubjectKind `bson:"kind" json:"kind"`
+	Username       string                `bson:"username" json:"username"` // Author of the message or bio.
+	ConversationId *primitive.ObjectID   `bson:"conversation_id,omitempty" json:"conversation_id,omitempty"`
+	MessageId      *primitive.ObjectID   `bson:"message_id,omitempty" json:"message_id,omitempty"`
+}
+
+// ModerationFinding is a rule a message or bio broke.
+type ModerationFinding struct {
+	RuleId primitive.ObjectID `bson:"rule_id" json:"rule_id"`
+	Kind   ModerationRuleKind `bson:"kind" json:"kind"`
+	Action ModerationAction   `bson:"action" json:"action"`
+	Match  string             `bson:"match" json:"match"` // Part of the text breaking the rule, or what the rate-based rules counted.
+}
+
+// ModerationFlag records, for moderators, the rules a message or bio broke and the action taken.
+type ModerationFlag struct {
+	Id        primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	Subject   ModerationSubject   `bson:"subject" json:"subject"`
+	At        time.Time           `bson:"at" json:"at"`     // When the message was sent or edited, or the bio edited, telling its versions apart.
+	Text      string              `bson:"text" json:"text"` // As it was when moderated.
+	Findings  []ModerationFinding `bson:"findings" json:"findings"`
+	Action    ModerationAction    `bson:"action" json:"action"`
+	CreatedAt time.Time           `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,27 @@
+package models
+
+// Audience describes who is allowed to see a piece of a user's profile.
+type Audience string
+
+const (
+	AudienceEveryone Audience = "everyone"
+	AudienceContacts Audience = "contacts"
+	AudienceNobody   Audience = "nobody"
+)
+
+type PrivacySettings struct {
+	HiddenFromSearch  bool     `bson:"hidden_from_search" json:"hidden_from_search"`
+	ProfileVisibility Audience `bson:"profile_visibility" json:"profile_visibility"`
+	PictureVisibility Audience `bson:"picture_visibility" json:"picture_visibility"`
+	HideBio           bool     `bson:"hide_bio" json:"hide_bio"`
+	HideLearning      bool     `bson:"hide_learning" json:"hide_learning"`
+	HideProfileViews  bool     `bson:"hide_profile_views" json:"hide_profile_views"` // Opts out of profile view insights both as owner and as viewer.
+	HideEndorsements  bool     `bson:"hide_endorsements" json:"hide_endorsements"`
+}
+
+func DefaultPrivacySettings() PrivacySettings {
+	return PrivacySettings{
+		ProfileVisibility: AudienceEveryone,
+		PictureVisibility: AudienceEveryone,
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ProfileViewsDayFormat is the layout of the UTC day profile views are aggregated by.
+const ProfileViewsDayFormat = "2006-01-02"
+
+// ProfileViewStats holds the view counters of Owner's profile for a single day.
+type ProfileViewStats struct {
+	Id            primitive.ObjectID `bson:"_id,omitempty"`
+	Owner         string             `bson:"owner" json:"owner"`
+	Day           string             `bson:"day" json:"day"`
+	Views         int64              `bson:"views" json:"views"`
+	UniqueViewers int64              `bson:"unique_viewers" json:"unique_viewers"`
+}
+
+/*
+ProfileViewer records that Viewer viewed Owner's profile on Day. There is at most one per viewer per day.
+Viewers who opted out of profile view insights are counted but not Listed.
+*/
+type ProfileViewer struct {
+	Id           primitive.ObjectID `bson:"_id,omitempty"`
+	Owner        string             `bson:"owner" json:"owner"`
+	Viewer       string             `bson:"viewer" json:"viewer"`
+	Day          string             `bson:"day" json:"day"`
+	Views        int64              `bson:"views" json:"views"`
+	LastViewedAt time.Time          `bson:"last_viewed_at" json:"last_viewed_at"`
+	Listed       bool               `bson:"listed" json:"listed"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+)
+
+type RecommendationReasonKind string
+
+const (
+	RecommendationReasonTeachesYourSkills    RecommendationReasonKind = "teaches_your_skills"    // Teaches skills the user learns.
+	RecommendationReasonTeachesRelatedSkills RecommendationReasonKind = "teaches_related_skills" // Teaches skills often listed along with the ones the user learns.
+	RecommendationReasonSimilarProfile       RecommendationReasonKind = "similar_profile"        // Bio and skills share distinctive words with the user's.
+)
+
+// RecommendationReason explains part of why a user was recommended. Terms are the skills or words behind it.
+type RecommendationReason struct {
+	Kind  RecommendationReasonKind `bson:"kind" json:"kind"`
+	Terms []string                 `bson:"terms" json:"terms"`
+}
+
+// Recommendation is a user suggested to someone else, along with how strongly and why.
+type Recommendation struct {
+	Username string                 `bson:"username" json:"username"`
+	Score    float64                `bson:"score" json:"score"`
+	Reasons  []RecommendationReason `bson:"reasons" json:"reasons"`
+}
+
+// Recommendations are the best users to suggest to Owner, best first, as of ComputedAt.
+type Recommendations struct {
+	Owner      string           `bson:"_id" json:"owner"`
+	Items      []Recommendation `bson:"items" json:"items"`
+	ComputedAt time.Time        `bson:"computed_at" json:"computed_at"`
+}
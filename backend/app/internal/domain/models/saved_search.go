@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SavedSearch is a search Owner wants to be alerted about when new users start matching it.
+type SavedSearch struct {
+	Id            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Owner         string             `bson:"owner" json:"owner"`
+	Name          string             `bson:"name" json:"name"`
+	Username      string             `bson:"username" json:"username"`
+	UsernameInfix bool               `bson:"username_infix" json:"username_infix"`
+	Skills        []string           `bson:"skills" json:"skills"`
+	Mode          string             `bson:"mode" json:"mode"` // Search mode, exchange if empty.
+	Languages     []string           `bson:"languages" json:"languages"`
+	Query         string             `bson:"query" json:"query"`
+	Notify        bool               `bson:"notify" json:"notify"` // Also notify the owner by email or push, not only in the app.
+	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// SearchAlert tells Owner that Username started matching one of their saved searches.
+type SearchAlert struct {
+	Id              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Owner           string             `bson:"owner" json:"owner"`
+	SavedSearchId   primitive.ObjectID `bson:"saved_search_id" json:"saved_search_id"`
+	SavedSearchName string             `bson:"saved_search_name" json:"saved_search_name"`
+	Username        string             `bson:"username" json:"username"`
+	Read            bool               `bson:"read" json:"read"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+}
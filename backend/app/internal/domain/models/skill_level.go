@@ -0,0 +1,12 @@
+package models
+
+// SkillLevel is the user's own rating of their proficiency in a teaching skill.
+type SkillLevel struct {
+	Skill string `bson:"skill" json:"skill"`
+	Level int32  `bson:"level" json:"level"` // From MinSkillLevel to MaxSkillLevel.
+}
+
+const (
+	MinSkillLevel int32 = 1
+	MaxSkillLevel int32 = 5
+)
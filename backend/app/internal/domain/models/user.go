@@ -1,19 +1,65 @@
 package models
 
 import (
+	"slices"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type User struct {
-	Id         primitive.ObjectID `bson:"_id,omitempty"`
-	Username   string             `json:"username"`
-	Password   string             `json:"password"`
-	Email      string             `json:"email"`
-	Bio        string             `json:"bio"`
-	Teaching   []string           `json:"teaching"`
-	Learning   []string           `json:"learning"`
-	CreatedAt  time.Time          `json:"created_at"`
-	UpdatedAt  time.Time          `json:"updated_at"`
+	Id          primitive.ObjectID `bson:"_id,omitempty"`
+	Username    string             `json:"username"`
+	Password    string             `json:"password"`
+	Email       string             `json:"email"`
+	Bio         string             `json:"bio"`
+	Teaching    []string           `json:"teaching"`
+	Learning    []string           `json:"learning"`
+	Languages   []string           `json:"languages"`
+	SkillLevels []SkillLevel       `json:"skill_levels"`
+	Contacts    []string           `json:"contacts"`
+	Privacy     PrivacySettings    `json:"privacy"`
+	Moderator   bool               `json:"-"` // Only granted in the database, never through the API.
+
+	// only set by the moderation pipeline
+	BioHidden        bool `json:"-"` // The bio is hidden from everyone but the user.
+	ShadowRestricted bool `json:"-"` // The user's messages and bio are hidden from everyone but them, without telling them.
+
+	// derived from Username for username search, only set through UserRepository.CreateUser
+	UsernameLower    string   `json:"-"`
+	UsernameTrigrams []string `json:"-"`
+
+	// denormalized from the endorsements collection, only updated through UserRepository.AddEndorsements
+	Endorsements     []SkillEndorsements `json:"endorsements"`
+	EndorsementCount int64               `json:"endorsement_count"`
+
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	LastActiveAt time.Time `json:"last_active_at"` // Only updated through UserRepository.TouchUser.
+}
+
+// GetEndorsements returns the endorsement counts of the skills the user currently teaches.
+func (u *User) GetEndorsements() []SkillEndorsements {
+	endorsements := []SkillEndorsements{}
+	for _, endorsement := range u.Endorsements {
+		if endorsement.Count > 0 && slices.Contains(u.Teaching, endorsement.Skill) {
+			endorsements = append(endorsements, endorsement)
+		}
+	}
+	return endorsements
+}
+
+// GetSkillLevels returns the levels of the skills the user currently teaches.
+func (u *User) GetSkillLevels() []SkillLevel {
+	levels := []SkillLevel{}
+	for _, level := range u.SkillLevels {
+		if slices.Contains(u.Teaching, level.Skill) {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
+func (u *User) HasContact(username string) bool {
+	return slices.Contains(u.Contacts, username)
 }
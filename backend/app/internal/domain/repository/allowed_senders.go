@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+type AllowedSenderRepository interface {
+	Allow(ctx context.Context, owner string, username string) error
+	Disallow(ctx context.Context, owner string, username string) error
+	ListAllowed(ctx context.Context, owner string, page int64, pagesize int64) ([]models.AllowedSender, error)
+	IsAllowed(ctx context.Context, owner string, username string) (bool, error)
+}
+
+type allowedSenderRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewAllowedSenderRepository(m *imongo.Client, l *slog.Logger) AllowedSenderRepository {
+	return &allowedSenderRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	allowedSendersCollectionName = "allowed_senders"
+)
+
+// Allow is idempotent: allowing an already allowed user keeps the original entry.
+func (r *allowedSenderRepositoryImpl) Allow(ctx context.Context, owner string, username string) error {
+	filter := bson.M{"owner": owner, "username": username}
+	update := bson.M{"$setOnInsert": models.AllowedSender{Owner: owner, Username: username, CreatedAt: time.Now()}}
+
+	_, err := r.mongo.Database.Collection(allowedSendersCollectionName).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		r.logger.Error("failed to allow sender", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *allowedSenderRepositoryImpl) Disallow(ctx context.Context, owner string, username string) error {
+	_, err := r.mongo.Database.Collection(allowedSendersCollectionName).DeleteOne(ctx, bson.M{"owner": owner, "username": username})
+	if err != nil {
+		r.logger.Error("failed to disallow sender", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *allowedSenderRepositoryImpl) ListAllowed(ctx context.Context, owner string, page int64, pagesize int64) ([]models.AllowedSender, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetSkip(page * pagesize).SetLimit(pagesize)
+
+	cur, err := r.mongo.Database.Collection(allowedSendersCollectionName).Find(ctx, bson.M{"owner": owner}, opts)
+	if err != nil {
+		r.logger.Error("failed to find in allowed senders collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	allowed := []models.AllowedSender{}
+	if err := cur.All(ctx, &allowed); err != nil {
+		r.logger.Error("failed to extract allowed senders from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return allowed, nil
+}
+
+// IsAllowed reports whether the owner lets the user message them without a request.
+func (r *allowedSenderRepositoryImpl) IsAllowed(ctx context.Context, owner string, username string) (bool, error) {
+	count, err := r.mongo.Database.Collection(allowedSendersCollectionName).CountDocuments(ctx, bson.M{"owner": owner, "username": username}, options.Count().SetLimit(1))
+	if err != nil {
+		r.logger.Error("failed to count allowed senders", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	return count > 0, nil
+}
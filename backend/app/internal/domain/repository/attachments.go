@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+type AttachmentRepository interface {
+	CreateAttachment(ctx context.Context, attachment models.Attachment) (*models.Attachment, error)
+	GetAttachment(ctx context.Context, conversationId primitive.ObjectID, id primitive.ObjectID) (*models.Attachment, error)
+	GetAttachments(ctx context.Context, conversationId primitive.ObjectID, ids []primitive.ObjectID) ([]models.Attachment, error)
+	SetAttachmentChecked(ctx context.Context, attachment models.Attachment) error
+	AttachToMessage(ctx context.Context, ids []primitive.ObjectID, messageId primitive.ObjectID) error
+}
+
+type attachmentRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewAttachmentRepository(m *imongo.Client, l *slog.Logger) AttachmentRepository {
+	return &attachmentRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	attachmentsCollectionName = "attachments"
+)
+
+// CreateAttachment stores the attachment as pending, waiting for its file.
+func (r *attachmentRepositoryImpl) CreateAttachment(ctx context.Context, attachment models.Attachment) (*models.Attachment, error) {
+	attachment.Id = primitive.NewObjectID()
+	attachment.Status = models.AttachmentStatusPending
+	attachment.CreatedAt = time.Now()
+
+	_, err := r.mongo.Database.Collection(attachmentsCollectionName).InsertOne(ctx, attachment)
+	if err != nil {
+		r.logger.Error("failed to insert attachment", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &attachment, nil
+}
+
+// GetAttachment gets the attachment if it was uploaded to the conversation.
+func (r *attachmentRepositoryImpl) GetAttachment(ctx context.Context, conversationId primitive.ObjectID, id primitive.ObjectID) (*models.Attachment, error) {
+	var attachment models.Attachment
+	err := r.mongo.Database.Collection(attachmentsCollectionName).FindOne(ctx, bson.M{"_id": id, "conversation_id": conversationId}).Decode(&attachment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrAttachmentNotFound
+		}
+		r.logger.Error("failed to find attachment", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &attachment, nil
+}
+
+// GetAttachments gets the attachments with the ids that were uploaded to the conversation, in no particular order.
+func (r *attachmentRepositoryImpl) GetAttachments(ctx context.Context, conversationId primitive.ObjectID, ids []primitive.ObjectID) ([]models.Attachment, error) {
+	cur, err := r.mongo.Database.Collection(attachmentsCollectionName).Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "conversation_id": conversationId})
+	if err != nil {
+		r.logger.Error("failed to find in attachments collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	attachments := []models.Attachment{}
+	if err := cur.All(ctx, &attachments); err != nil {
+		r.logger.Error("failed to extract attachments from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return attachments, nil
+}
+
+// SetAttachmentChecked records the outcome of checking the file of the attachment: its status, type, size and preview.
+func (r *attachmentRepositoryImpl) SetAttachmentChecked(ctx context.Context, attachment models.Attachment) error {
+	update := bson.M{"$set": bson.M{
+		"status":       attachment.Status,
+		"content_type": attachment.ContentType,
+		"size":         attachment.Size,
+		"has_preview":  attachment.HasPreview,
+	}}
+	_, err := r.mongo.Database.Collection(attachmentsCollectionName).UpdateByID(ctx, attachment.Id, update)
+	if err != nil {
+		r.logger.Error("failed to update attachment", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// AttachToMessage records the attachments as sent in the message.
+func (r *attachmentRepositoryImpl) AttachToMessage(ctx context.Context, ids []primitive.ObjectID, messageId primitive.ObjectID) error {
+	_, err := r.mongo.Database.Collection(attachmentsCollectionName).UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, bson.M{"$set": bson.M{"message_id": messageId}})
+	if err != nil {
+		r.logger.Error("failed to attach attachments to message", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+type BlockRepository interface {
+	Block(ctx context.Context, blocker string, blocked string) error
+	Unblock(ctx context.Context, blocker string, blocked string) error
+	ListBlocked(ctx context.Context, blocker string, page int64, pagesize int64) ([]models.Block, error)
+	IsBlocked(ctx context.Context, first string, second string) (bool, error)
+	GetBlockedUsernames(ctx context.Context, username string) ([]string, error)
+}
+
+type blockRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewBlockRepository(m *imongo.Client, l *slog.Logger) BlockRepository {
+	return &blockRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	blocksCollectionName = "blocks"
+)
+
+// Block is idempotent: blocking an already blocked user keeps the original block.
+func (r *blockRepositoryImpl) Block(ctx context.Context, blocker string, blocked string) error {
+	filter := bson.M{"blocker": blocker, "blocked": blocked}
+	update := bson.M{"$setOnInsert": models.Block{Blocker: blocker, Blocked: blocked, CreatedAt: time.Now()}}
+
+	_, err := r.mongo.Database.Collection(blocksCollectionName).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	// the unique blocker and blocked index lets only one of concurrent upserts insert, the others find the user blocked
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		r.logger.Error("failed to block user", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *blockRepositoryImpl) Unblock(ctx context.Context, blocker string, blocked string) error {
+	_, err := r.mongo.Database.Collection(blocksCollectionName).DeleteOne(ctx, bson.M{"blocker": blocker, "blocked": blocked})
+	if err != nil {
+		r.logger.Error("failed to unblock user", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *blockRepositoryImpl) ListBlocked(ctx context.Context, blocker string, page int64, pagesize int64) ([]models.Block, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetSkip(page * pagesize).SetLimit(pagesize)
+
+	cur, err := r.mongo.Database.Collection(blocksCollectionName).Find(ctx, bson.M{"blocker": blocker}, opts)
+	if err != nil {
+		r.logger.Error("failed to find in blocks collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	blocks := []models.Block{}
+	if err := cur.All(ctx, &blocks); err != nil {
+		r.logger.Error("failed to extract blocks from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return blocks, nil
+}
+
+// IsBlocked reports whether either of the users has blocked the other.
+func (r *blockRepositoryImpl) IsBlocked(ctx context.Context, first string, second string) (bool, error) {
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"blocker": first, "blocked": second},
+			bson.M{"blocker": second, "blocked": first},
+		},
+	}
+
+	count, err := r.mongo.Database.Collection(blocksCollectionName).CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		r.logger.Error("failed to count blocks", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	return count > 0, nil
+}
+
+// GetBlockedUsernames returns the users the given user has blocked or has been blocked by.
+func (r *blockRepositoryImpl) GetBlockedUsernames(ctx context.Context, username string) ([]string, error) {
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"blocker": username},
+			bson.M{"blocked": username},
+		},
+	}
+	opts := options.Find().SetProjection(bson.M{"blocker": 1, "blocked": 1})
+
+	cur, err := r.mongo.Database.Collection(blocksCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("failed to find in blocks collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	var blocks []models.Block
+	if err := cur.All(ctx, &blocks); err != nil {
+		r.logger.Error("failed to extract blocks from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	usernames := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Blocker == username {
+			usernames = append(usernames, block.Blocked)
+		} else {
+			usernames = append(usernames, block.Blocker)
+		}
+	}
+
+	return usernames, nil
+}
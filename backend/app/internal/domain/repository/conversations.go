@@ -0,0 +1,831 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+var (
+	ErrConversationNotFound = errors.New("conversation not found")
+	ErrMessageNotFound      = errors.New("message not found")
+	ErrRequestNotFound      = errors.New("message request not found")
+	ErrRequestPending       = errors.New("message request pending")
+)
+
+// ChatPosition is where a page of conversations or messages ends, so the next one can continue from there.
+type ChatPosition struct {
+	At time.Time          `bson:"at"` // Sort time of the last item, UpdatedAt for conversations and CreatedAt for messages and circles.
+	Id primitive.ObjectID `bson:"id"`
+}
+
+type ConversationPage struct {
+	Conversations []models.Conversation
+	HasMore       bool
+	Next          ChatPosition // Only set if HasMore.
+}
+
+type MessagePage struct {
+	Messages []models.Message
+	HasMore  bool
+	Next     ChatPosition // Only set if HasMore.
+}
+
+// MessageSearchQuery describes the messages SearchMessages should look for.
+type MessageSearchQuery struct {
+	Viewer          string               // Messages the viewer deleted for themselves are skipped.
+	Text            string               // Free text looked up in the text index over message texts.
+	ConversationIds []primitive.ObjectID // Conversations searched, which the viewer must be allowed to read.
+	Sender          string               // Only messages sent by this user, if set.
+	Since           *time.Time           // Only messages sent at or after this time, if set.
+	Until           *time.Time           // Only messages sent before this time, if set.
+	After           *ChatPosition
+	Pagesize        int64
+}
+
+// UnreadCount is the number of messages of a conversation a participant hasn't read.
+type UnreadCount struct {
+	ConversationId primitive.ObjectID      `bson:"_id"`
+	Kind           models.ConversationKind `bson:"kind"`
+	Participants   []string                `bson:"participants"`
+	Count          int64                   `bson:"unread_count"`
+}
+
+type ConversationRepository interface {
+	GetOrCreateConversation(ctx context.Context, first string, second string, request *models.MessageRequest) (*models.Conversation, error)
+	FindDirectConversation(ctx context.Context, first string, second string) (*models.Conversation, error)
+	GetConversation(ctx context.Context, id primitive.ObjectID, participant string) (*models.Conversation, error)
+	ListConversations(ctx context.Context, participant string, excludeUsernames []string, after *ChatPosition, pagesize int64) (*ConversationPage, error)
+	GetConversationIds(ctx context.Context, participant string, excludeUsernames []string) ([]primitive.ObjectID, error)
+	ListMessageRequests(ctx context.Context, recipient string, excludeUsernames []string, after *ChatPosition, pagesize int64) (*ConversationPage, error)
+	CountMessageRequestsSince(ctx context.Context, sender string, since time.Time) (int64, error)
+	SetMessageRequestStatus(ctx context.Context, id primitive.ObjectID, recipient string, status models.MessageRequestStatus) error
+	GetConversationPartners(ctx context.Context, participant string) ([]string, error)
+	GetUnreadCounts(ctx context.Context, participant string, excludeUsernames []string) ([]UnreadCount, error)
+	CreateMessage(ctx context.Context, message models.Message, requestLimit int64) (*models.Message, error)
+	GetMessage(ctx context.Context, conversationId primitive.ObjectID, id primitive.ObjectID) (*models.Message, error)
+	FindMessage(ctx context.Context, id primitive.ObjectID) (*models.Message, error)
+	ListMessages(ctx context.Context, conversationId primitive.ObjectID, viewer string, after *ChatPosition, pagesize int64) (*MessagePage, error)
+	SearchMessages(ctx context.Context, query MessageSearchQuery) (*MessagePage, error)
+	EditMessage(ctx context.Context, message models.Message, text string) (*models.Message, error)
+	DeleteMessage(ctx context.Context, message models.Message) (*models.Message, error)
+	HideMessage(ctx context.Context, message models.Message, participant string) error
+	RestrictMessage(ctx context.Context, message models.Message) error
+	CountMessagesSince(ctx context.Context, sender string, since time.Time) (int64, error)
+	CountConversationsWithText(ctx context.Context, sender string, text string, since time.Time) (int64, error)
+	GetMessageRevisions(ctx context.Context, messageId primitive.ObjectID) ([]models.MessageRevision, error)
+	MarkRead(ctx context.Context, message models.Message, participant string) (*models.ReadCursor, error)
+	EnsureIndexes(ctx context.Context) error
+}
+
+type conversationRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewConversationRepository(m *imongo.Client, l *slog.Logger) ConversationRepository {
+	return &conversationRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	conversationsCollectionName    = "conversations"
+	messagesCollectionName         = "messages"
+	messageRevisionsCollectionName = "message_revisions"
+	messagesTextIndexName          = "messages_text"
+)
+
+/*
+GetOrCreateConversation returns the direct conversation between the two users, starting it if there is none yet, as
+the request if any.
+*/
+func (r *conversationRepositoryImpl) GetOrCreateConversation(ctx context.Context, first string, second string, request *models.MessageRequest) (*models.Conversation, error) {
+	participants := []string{first, second}
+	slices.Sort(participants)
+
+	now := time.Now()
+	filter := bson.M{"kind": models.ConversationKindDirect, "participants": participants}
+	reads := make([]models.ReadCursor, len(participants))
+	for i, participant := range participants {
+		reads[i] = models.ReadCursor{Username: participant}
+	}
+	insert := bson.M{
+		"message_count": 0,
+		"reads":         reads,
+		"created_at":    now,
+		"updated_at":    now,
+	}
+	if request != nil {
+		insert["request"] = request
+	}
+	update := bson.M{"$setOnInsert": insert}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var conversation models.Conversation
+	err := r.mongo.Database.Collection(conversationsCollectionName).FindOneAndUpdate(ctx, filter, update, opts).Decode(&conversation)
+	// the unique participants index lets only one of concurrent upserts insert, the others find its conversation
+	if mongo.IsDuplicateKeyError(err) {
+		err = r.mongo.Database.Collection(conversationsCollectionName).FindOne(ctx, filter).Decode(&conversation)
+	}
+	if err != nil {
+		r.logger.Error("failed to get or create conversation", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &conversation, nil
+}
+
+// FindDirectConversation gets the direct conversation between the two users, if they have one.
+func (r *conversationRepositoryImpl) FindDirectConversation(ctx context.Context, first string, second string) (*models.Conversation, error) {
+	participants := []string{first, second}
+	slices.Sort(participants)
+
+	var conversation models.Conversation
+	err := r.mongo.Database.Collection(conversationsCollectionName).FindOne(ctx, bson.M{"kind": models.ConversationKindDirect, "participants": participants}).Decode(&conversation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrConversationNotFound
+		}
+		r.logger.Error("failed to find conversation", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &conversation, nil
+}
+
+// GetConversation gets the conversation if the user takes part in it.
+func (r *conversationRepositoryImpl) GetConversation(ctx context.Context, id primitive.ObjectID, participant string) (*models.Conversation, error) {
+	var conversation models.Conversation
+	err := r.mongo.Database.Collection(conversationsCollectionName).FindOne(ctx, bson.M{"_id": id, "participants": participant}).Decode(&conversation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrConversationNotFound
+		}
+		r.logger.Error("failed to find conversation", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &conversation, nil
+}
+
+/*
+ListConversations lists the user's conversations, most recently active first, leaving out the direct ones with
+excludeUsernames and the requests the user hasn't accepted.
+*/
+func (r *conversationRepositoryImpl) ListConversations(ctx context.Context, participant string, excludeUsernames []string, after *ChatPosition, pagesize int64) (*ConversationPage, error) {
+	filter := participatingFilter(participant, excludeUsernames)
+	if after != nil {
+		filter["$and"] = bson.A{bson.M{"$or": before("updated_at", *after)}}
+	}
+	return findConversationPage(ctx, r.mongo, r.logger, filter, "updated_at", pagesize)
+}
+
+// GetConversationIds gets the ids of the conversations ListConversations lists for the user.
+func (r *conversationRepositoryImpl) GetConversationIds(ctx context.Context, participant string, excludeUsernames []string) ([]primitive.ObjectID, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 1})
+	cur, err := r.mongo.Database.Collection(conversationsCollectionName).Find(ctx, participatingFilter(participant, excludeUsernames), opts)
+	if err != nil {
+		r.logger.Error("failed to find in conversations collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	var conversations []struct {
+		Id primitive.ObjectID `bson:"_id"`
+	}
+	if err := cur.All(ctx, &conversations); err != nil {
+		r.logger.Error("failed to extract conversations from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	ids := make([]primitive.ObjectID, len(conversations))
+	for i, conversation := range conversations {
+		ids[i] = conversation.Id
+	}
+	return ids, nil
+}
+
+/*
+ListMessageRequests lists the pending requests to the user that have messages, most recently active first, leaving out
+those from excludeUsernames.
+*/
+func (r *conversationRepositoryImpl) ListMessageRequests(ctx context.Context, recipient string, excludeUsernames []string, after *ChatPosition, pagesize int64) (*ConversationPage, error) {
+	filter := bson.M{
+		"request.to":     recipient,
+		"request.status": models.MessageRequestPending,
+		"message_count":  bson.M{"$gt": 0},
+	}
+	if len(excludeUsernames) > 0 {
+		filter["request.from"] = bson.M{"$nin": excludeUsernames}
+	}
+	if after != nil {
+		filter["$or"] = before("updated_at", *after)
+	}
+	return findConversationPage(ctx, r.mongo, r.logger, filter, "updated_at", pagesize)
+}
+
+/*
+findConversationPage finds a page of the conversations matching the filter, sorted by the time field, updated_at or
+created_at, then id, both descending.
+*/
+func findConversationPage(ctx context.Context, m *imongo.Client, logger *slog.Logger, filter bson.M, field string, pagesize int64) (*ConversationPage, error) {
+	opts := options.Find().SetSort(bson.D{{Key: field, Value: -1}, {Key: "_id", Value: -1}}).SetLimit(pagesize + 1)
+
+	cur, err := m.Database.Collection(conversationsCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		logger.Error("failed to find in conversations collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	conversations := []models.Conversation{}
+	if err := cur.All(ctx, &conversations); err != nil {
+		logger.Error("failed to extract conversations from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	page := &ConversationPage{Conversations: conversations}
+	if int64(len(conversations)) > pagesize {
+		page.Conversations = conversations[:pagesize]
+		last := page.Conversations[pagesize-1]
+		page.HasMore = true
+		page.Next = ChatPosition{At: last.UpdatedAt, Id: last.Id}
+		if field == "created_at" {
+			page.Next.At = last.CreatedAt
+		}
+	}
+
+	return page, nil
+}
+
+// CountMessageRequestsSince counts the requests the user sent since the time, whatever became of them.
+func (r *conversationRepositoryImpl) CountMessageRequestsSince(ctx context.Context, sender string, since time.Time) (int64, error) {
+	filter := bson.M{"request.from": sender, "request.created_at": bson.M{"$gte": since}}
+	count, err := r.mongo.Database.Collection(conversationsCollectionName).CountDocuments(ctx, filter)
+	if err != nil {
+		r.logger.Error("failed to count message requests", slog.Any("error", err))
+		return 0, ErrInternal
+	}
+
+	return count, nil
+}
+
+/*
+SetMessageRequestStatus accepts or declines the request to the user. Accepted requests are final, while declined ones
+can still be accepted.
+*/
+func (r *conversationRepositoryImpl) SetMessageRequestStatus(ctx context.Context, id primitive.ObjectID, recipient string, status models.MessageRequestStatus) error {
+	filter := bson.M{"_id": id, "request.to": recipient, "request.status": bson.M{"$ne": models.MessageRequestAccepted}}
+	update := bson.M{"$set": bson.M{"request.status": status, "request.decided_at": time.Now()}}
+	result, err := r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("failed to set message request status", slog.Any("error", err))
+		return ErrInternal
+	}
+	if result.MatchedCount == 0 {
+		return ErrRequestNotFound
+	}
+
+	return nil
+}
+
+// GetConversationPartners returns the users the user has a direct conversation with, once any request was accepted.
+func (r *conversationRepositoryImpl) GetConversationPartners(ctx context.Context, participant string) ([]string, error) {
+	filter := bson.M{
+		"kind":           models.ConversationKindDirect,
+		"participants":   participant,
+		"request.status": bson.M{"$nin": bson.A{models.MessageRequestPending, models.MessageRequestDeclined}},
+	}
+	values, err := r.mongo.Database.Collection(conversationsCollectionName).Distinct(ctx, "participants", filter)
+	if err != nil {
+		r.logger.Error("failed to find conversation partners", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	partners := make([]string, 0, len(values))
+	for _, value := range values {
+		if username, ok := value.(string); ok && username != participant {
+			partners = append(partners, username)
+		}
+	}
+
+	return partners, nil
+}
+
+/*
+GetUnreadCounts counts the unread messages of the user's conversations that have any, leaving out the direct ones with
+excludeUsernames and the requests the user hasn't accepted.
+*/
+func (r *conversationRepositoryImpl) GetUnreadCounts(ctx context.Context, participant string, excludeUsernames []string) ([]UnreadCount, error) {
+	filter := participatingFilter(participant, excludeUsernames)
+	readSeq := bson.M{"$first": bson.M{"$map": bson.M{
+		"input": bson.M{"$filter": bson.M{
+			"input": "$reads",
+			"cond":  bson.M{"$eq": bson.A{"$$this.username", bson.M{"$literal": participant}}},
+		}},
+		"in": "$$this.seq",
+	}}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$project", Value: bson.M{
+			"kind":         1,
+			"participants": 1,
+			"unread_count": bson.M{"$subtract": bson.A{"$message_count", bson.M{"$ifNull": bson.A{readSeq, 0}}}},
+		}}},
+		{{Key: "$match", Value: bson.M{"unread_count": bson.M{"$gt": 0}}}},
+	}
+
+	cur, err := r.mongo.Database.Collection(conversationsCollectionName).Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("failed to aggregate unread counts", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	counts := []UnreadCount{}
+	if err := cur.All(ctx, &counts); err != nil {
+		r.logger.Error("failed to extract unread counts from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return counts, nil
+}
+
+/*
+CreateMessage stores the message and makes it the last one of its conversation, read by its sender.
+The conversation counts its messages as they are sent, so that unread counts only take subtracting the seq of the last
+message read. If requestLimit is positive, the sender of a request not accepted yet can only send while the
+conversation has fewer messages, checked along with counting the message so concurrent ones can't go past it;
+ErrRequestPending is returned otherwise.
+A restricted message is counted all the same, but moves the other participants who had read everything past it and
+leaves the last message alone, so that they can't tell it was sent.
+*/
+func (r *conversationRepositoryImpl) CreateMessage(ctx context.Context, message models.Message, requestLimit int64) (*models.Message, error) {
+	message.Id = primitive.NewObjectID()
+	message.CreatedAt = time.Now()
+
+	reader := bson.M{"$eq": bson.A{"$$this.username", bson.M{"$literal": message.Sender}}}
+	read := bson.M{"username": "$$this.username", "seq": "$message_count", "message_id": message.Id, "at": message.CreatedAt}
+	if message.Restricted {
+		// the receipts keep the last message the others can see
+		reader = bson.M{"$or": bson.A{reader, bson.M{"$eq": bson.A{"$$this.seq", bson.M{"$subtract": bson.A{"$message_count", 1}}}}}}
+		read = bson.M{"username": "$$this.username", "seq": "$message_count", "message_id": "$$this.message_id", "at": "$$this.at"}
+	}
+	sent := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"message_count": bson.M{"$add": bson.A{"$message_count", 1}}}}},
+		{{Key: "$set", Value: bson.M{"reads": bson.M{"$map": bson.M{
+			"input": "$reads",
+			"in":    bson.M{"$cond": bson.A{reader, read, "$$this"}},
+		}}}}},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After).SetProjection(bson.M{"message_count": 1})
+
+	filter := bson.M{"_id": message.ConversationId}
+	if requestLimit > 0 {
+		filter["$or"] = bson.A{
+			bson.M{"request": nil},
+			bson.M{"request.status": models.MessageRequestAccepted},
+			bson.M{"request.from": bson.M{"$ne": message.Sender}},
+			bson.M{"message_count": bson.M{"$lt": requestLimit}},
+		}
+	}
+
+	var conversation models.Conversation
+	err := r.mongo.Database.Collection(conversationsCollectionName).FindOneAndUpdate(ctx, filter, sent, opts).Decode(&conversation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, r.notSentError(ctx, message.ConversationId, requestLimit)
+		}
+		r.logger.Error("failed to count message", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	message.Seq = conversation.MessageCount
+
+	_, err = r.mongo.Database.Collection(messagesCollectionName).InsertOne(ctx, message)
+	if err != nil {
+		r.logger.Error("failed to insert message", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	if message.Restricted {
+		return &message, nil
+	}
+
+	// a message sent concurrently may already be the last one, in which case it is kept
+	filter = bson.M{"_id": message.ConversationId, "last_message.seq": bson.M{"$not": bson.M{"$gt": message.Seq}}}
+	update := bson.M{"$set": bson.M{"last_message": message, "updated_at": message.CreatedAt}}
+	_, err = r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("failed to update conversation", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &message, nil
+}
+
+// notSentError tells apart a message not sent because of its conversation missing from one past the request limit.
+func (r *conversationRepositoryImpl) notSentError(ctx context.Context, conversationId primitive.ObjectID, requestLimit int64) error {
+	if requestLimit <= 0 {
+		return ErrConversationNotFound
+	}
+	count, err := r.mongo.Database.Collection(conversationsCollectionName).CountDocuments(ctx, bson.M{"_id": conversationId}, options.Count().SetLimit(1))
+	if err != nil {
+		r.logger.Error("failed to count conversations", slog.Any("error", err))
+		return ErrInternal
+	}
+	if count == 0 {
+		return ErrConversationNotFound
+	}
+	return ErrRequestPending
+}
+
+// GetMessage gets the message if it belongs to the conversation.
+func (r *conversationRepositoryImpl) GetMessage(ctx context.Context, conversationId primitive.ObjectID, id primitive.ObjectID) (*models.Message, error) {
+	var message models.Message
+	err := r.mongo.Database.Collection(messagesCollectionName).FindOne(ctx, bson.M{"_id": id, "conversation_id": conversationId}).Decode(&message)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMessageNotFound
+		}
+		r.logger.Error("failed to find message", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &message, nil
+}
+
+// FindMessage gets the message whatever its conversation, for moderators.
+func (r *conversationRepositoryImpl) FindMessage(ctx context.Context, id primitive.ObjectID) (*models.Message, error) {
+	var message models.Message
+	err := r.mongo.Database.Collection(messagesCollectionName).FindOne(ctx, bson.M{"_id": id}).Decode(&message)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMessageNotFound
+		}
+		r.logger.Error("failed to find message", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &message, nil
+}
+
+/*
+ListMessages lists the messages of the conversation the viewer hasn't deleted for themselves and moderation doesn't hide
+from them, newest first.
+*/
+func (r *conversationRepositoryImpl) ListMessages(ctx context.Context, conversationId primitive.ObjectID, viewer string, after *ChatPosition, pagesize int64) (*MessagePage, error) {
+	filter := visibleMessagesFilter(viewer)
+	filter["conversation_id"] = conversationId
+	if after != nil {
+		filter["$or"] = before("created_at", *after)
+	}
+	return r.findMessagePage(ctx, filter, pagesize)
+}
+
+/*
+SearchMessages searches the texts of the messages of the given conversations, newest first. Messages deleted for
+everyone, or hidden from the viewer like ListMessages does, are never found.
+*/
+func (r *conversationRepositoryImpl) SearchMessages(ctx context.Context, query MessageSearchQuery) (*MessagePage, error) {
+	filter := visibleMessagesFilter(query.Viewer)
+	filter["$text"] = bson.M{"$search": query.Text}
+	filter["conversation_id"] = bson.M{"$in": query.ConversationIds}
+	filter["deleted_at"] = nil
+	if len(query.Sender) > 0 {
+		filter["sender"] = query.Sender
+	}
+	createdAt := bson.M{}
+	if query.Since != nil {
+		createdAt["$gte"] = *query.Since
+	}
+	if query.Until != nil {
+		createdAt["$lt"] = *query.Until
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+	if query.After != nil {
+		filter["$or"] = before("created_at", *query.After)
+	}
+	return r.findMessagePage(ctx, filter, query.Pagesize)
+}
+
+// findMessagePage finds a page of the messages matching the filter, newest first.
+func (r *conversationRepositoryImpl) findMessagePage(ctx context.Context, filter bson.M, pagesize int64) (*MessagePage, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetLimit(pagesize + 1)
+
+	cur, err := r.mongo.Database.Collection(messagesCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("failed to find in messages collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	messages := []models.Message{}
+	if err := cur.All(ctx, &messages); err != nil {
+		r.logger.Error("failed to extract messages from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	page := &MessagePage{Messages: messages}
+	if int64(len(messages)) > pagesize {
+		page.Messages = messages[:pagesize]
+		last := page.Messages[pagesize-1]
+		page.HasMore = true
+		page.Next = ChatPosition{At: last.CreatedAt, Id: last.Id}
+	}
+
+	return page, nil
+}
+
+/*
+EditMessage replaces the text of the message, unless it was deleted meanwhile, keeping the previous one as a revision.
+The conversation's last message is kept up to date.
+*/
+func (r *conversationRepositoryImpl) EditMessage(ctx context.Context, message models.Message, text string) (*models.Message, error) {
+	now := time.Now()
+	return r.reviseMessage(ctx, message, models.MessageRevisionEdit, bson.M{
+		"$set": bson.M{"text": text, "edited_at": now},
+	}, now)
+}
+
+/*
+DeleteMessage replaces the message with a tombstone, unless it was deleted already, keeping its content as a revision.
+The conversation's last message is kept up to date.
+*/
+func (r *conversationRepositoryImpl) DeleteMessage(ctx context.Context, message models.Message) (*models.Message, error) {
+	now := time.Now()
+	return r.reviseMessage(ctx, message, models.MessageRevisionDelete, bson.M{
+		"$set":   bson.M{"text": "", "deleted_at": now},
+		"$unset": bson.M{"attachments": ""},
+	}, now)
+}
+
+func (r *conversationRepositoryImpl) reviseMessage(ctx context.Context, message models.Message, kind models.MessageRevisionKind, update bson.M, now time.Time) (*models.Message, error) {
+	// the revision is stored first, so that no content is ever lost; a failed update only leaves a redundant one
+	_, err := r.mongo.Database.Collection(messageRevisionsCollectionName).InsertOne(ctx, models.MessageRevision{
+		MessageId:   message.Id,
+		Kind:        kind,
+		Text:        message.Text,
+		Attachments: message.Attachments,
+		RevisedAt:   now,
+	})
+	if err != nil {
+		r.logger.Error("failed to insert message revision", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	filter := bson.M{"_id": message.Id, "deleted_at": nil}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var revised models.Message
+	err = r.mongo.Database.Collection(messagesCollectionName).FindOneAndUpdate(ctx, filter, update, opts).Decode(&revised)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMessageNotFound
+		}
+		r.logger.Error("failed to revise message", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	filter = bson.M{"_id": revised.ConversationId, "last_message._id": revised.Id}
+	_, err = r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, filter, bson.M{"$set": bson.M{"last_message": revised}})
+	if err != nil {
+		r.logger.Error("failed to update conversation", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &revised, nil
+}
+
+// HideMessage deletes the message for the participant only.
+func (r *conversationRepositoryImpl) HideMessage(ctx context.Context, message models.Message, participant string) error {
+	update := bson.M{"$addToSet": bson.M{"hidden_for": participant}}
+	_, err := r.mongo.Database.Collection(messagesCollectionName).UpdateByID(ctx, message.Id, update)
+	if err != nil {
+		r.logger.Error("failed to hide message", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	// the last message is hidden from the participant when listing conversations, so it has to be kept up to date too
+	filter := bson.M{"_id": message.ConversationId, "last_message._id": message.Id}
+	_, err = r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, filter, bson.M{"$addToSet": bson.M{"last_message.hidden_for": participant}})
+	if err != nil {
+		r.logger.Error("failed to update conversation", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+/*
+RestrictMessage hides the message from everyone but its sender, on behalf of moderation. The other participants who had
+read up to it are moved past it, since they can't mark it read themselves.
+*/
+func (r *conversationRepositoryImpl) RestrictMessage(ctx context.Context, message models.Message) error {
+	_, err := r.mongo.Database.Collection(messagesCollectionName).UpdateByID(ctx, message.Id, bson.M{"$set": bson.M{"restricted": true}})
+	if err != nil {
+		r.logger.Error("failed to restrict message", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: []any{
+		bson.M{"read.username": bson.M{"$ne": message.Sender}, "read.seq": message.Seq - 1},
+	}})
+	_, err = r.mongo.Database.Collection(conversationsCollectionName).UpdateByID(ctx, message.ConversationId, bson.M{"$set": bson.M{"reads.$[read].seq": message.Seq}}, opts)
+	if err != nil {
+		r.logger.Error("failed to move read cursors past message", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	filter := bson.M{"_id": message.ConversationId, "last_message._id": message.Id}
+	_, err = r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, filter, bson.M{"$set": bson.M{"last_message.restricted": true}})
+	if err != nil {
+		r.logger.Error("failed to update conversation", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// CountMessagesSince counts the messages the user sent since the time, in all their conversations.
+func (r *conversationRepositoryImpl) CountMessagesSince(ctx context.Context, sender string, since time.Time) (int64, error) {
+	filter := bson.M{"sender": sender, "created_at": bson.M{"$gte": since}}
+	count, err := r.mongo.Database.Collection(messagesCollectionName).CountDocuments(ctx, filter)
+	if err != nil {
+		r.logger.Error("failed to count messages", slog.Any("error", err))
+		return 0, ErrInternal
+	}
+
+	return count, nil
+}
+
+// CountConversationsWithText counts the conversations the user sent the text to since the time.
+func (r *conversationRepositoryImpl) CountConversationsWithText(ctx context.Context, sender string, text string, since time.Time) (int64, error) {
+	filter := bson.M{"sender": sender, "created_at": bson.M{"$gte": since}, "text": text}
+	conversations, err := r.mongo.Database.Collection(messagesCollectionName).Distinct(ctx, "conversation_id", filter)
+	if err != nil {
+		r.logger.Error("failed to count conversations", slog.Any("error", err))
+		return 0, ErrInternal
+	}
+
+	return int64(len(conversations)), nil
+}
+
+// GetMessageRevisions returns the previous versions of the message, oldest first.
+func (r *conversationRepositoryImpl) GetMessageRevisions(ctx context.Context, messageId primitive.ObjectID) ([]models.MessageRevision, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "revised_at", Value: 1}, {Key: "_id", Value: 1}})
+	cur, err := r.mongo.Database.Collection(messageRevisionsCollectionName).Find(ctx, bson.M{"message_id": messageId}, opts)
+	if err != nil {
+		r.logger.Error("failed to find in message revisions collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	revisions := []models.MessageRevision{}
+	if err := cur.All(ctx, &revisions); err != nil {
+		r.logger.Error("failed to extract message revisions from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return revisions, nil
+}
+
+/*
+MarkRead moves the participant's read cursor of the message's conversation to the message. It returns the new cursor,
+or nil if the participant had already read the message.
+Messages hidden from the participant can't be marked read themselves, so when only such messages follow, the cursor is
+moved past them too, unless another message was sent meanwhile.
+*/
+func (r *conversationRepositoryImpl) MarkRead(ctx context.Context, message models.Message, participant string) (*models.ReadCursor, error) {
+	read := models.ReadCursor{Username: participant, Seq: message.Seq, MessageId: message.Id, At: time.Now()}
+
+	seq, err := r.hiddenUntil(ctx, message, participant)
+	if err != nil {
+		return nil, err
+	}
+	if seq > message.Seq {
+		past := read
+		past.Seq = seq
+		moved, err := r.moveReadCursor(ctx, bson.M{"_id": message.ConversationId, "message_count": seq}, past)
+		if err != nil {
+			return nil, err
+		}
+		if moved {
+			return &past, nil
+		}
+	}
+
+	moved, err := r.moveReadCursor(ctx, bson.M{"_id": message.ConversationId}, read)
+	if err != nil || !moved {
+		return nil, err
+	}
+
+	return &read, nil
+}
+
+// moveReadCursor moves the participant's read cursor forward to the one given, in the conversation matching the filter.
+func (r *conversationRepositoryImpl) moveReadCursor(ctx context.Context, filter bson.M, read models.ReadCursor) (bool, error) {
+	filter["reads"] = bson.M{"$elemMatch": bson.M{"username": read.Username, "seq": bson.M{"$lt": read.Seq}}}
+	result, err := r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, filter, bson.M{"$set": bson.M{"reads.$": read}})
+	if err != nil {
+		r.logger.Error("failed to mark messages as read", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
+/*
+hiddenUntil returns the conversation's message count if every message after the given one is hidden from the viewer,
+or the message's seq otherwise. Messages counted but not stored yet aren't found, and so are never taken for hidden.
+*/
+func (r *conversationRepositoryImpl) hiddenUntil(ctx context.Context, message models.Message, viewer string) (int64, error) {
+	var conversation models.Conversation
+	opts := options.FindOne().SetProjection(bson.M{"message_count": 1})
+	err := r.mongo.Database.Collection(conversationsCollectionName).FindOne(ctx, bson.M{"_id": message.ConversationId}, opts).Decode(&conversation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, ErrConversationNotFound
+		}
+		r.logger.Error("failed to find conversation", slog.Any("error", err))
+		return 0, ErrInternal
+	}
+	if conversation.MessageCount <= message.Seq {
+		return message.Seq, nil
+	}
+
+	// messages sent concurrently with this one may be older yet come after it, in which case nothing is taken for hidden
+	filter := bson.M{
+		"conversation_id": message.ConversationId,
+		"created_at":      bson.M{"$gte": message.CreatedAt},
+		"seq":             bson.M{"$gt": message.Seq, "$lte": conversation.MessageCount},
+		"$or": bson.A{
+			bson.M{"hidden_for": viewer},
+			bson.M{"restricted": true, "sender": bson.M{"$ne": viewer}},
+		},
+	}
+	hidden, err := r.mongo.Database.Collection(messagesCollectionName).CountDocuments(ctx, filter)
+	if err != nil {
+		r.logger.Error("failed to count hidden messages", slog.Any("error", err))
+		return 0, ErrInternal
+	}
+	if hidden < conversation.MessageCount-message.Seq {
+		return message.Seq, nil
+	}
+
+	return conversation.MessageCount, nil
+}
+
+// EnsureIndexes creates the text index over message texts, which depends on configuration like the one over users.
+func (r *conversationRepositoryImpl) EnsureIndexes(ctx context.Context) error {
+	return ensureTextIndex(ctx, r.mongo, r.logger, messagesCollectionName, mongo.IndexModel{
+		Keys:    bson.D{{Key: "text", Value: "text"}},
+		Options: options.Index().SetName(messagesTextIndexName),
+	})
+}
+
+// visibleMessagesFilter matches the messages the viewer hasn't deleted for themselves and moderation doesn't hide from them.
+func visibleMessagesFilter(viewer string) bson.M {
+	return bson.M{
+		"hidden_for": bson.M{"$ne": viewer},
+		"$nor":       bson.A{bson.M{"restricted": true, "sender": bson.M{"$ne": viewer}}},
+	}
+}
+
+/*
+participatingFilter matches the conversations the user takes part in, but the direct ones with excludeUsernames and the
+requests to the user they haven't accepted, which are listed apart.
+*/
+func participatingFilter(participant string, excludeUsernames []string) bson.M {
+	filter := bson.M{
+		"participants": participant,
+		"$nor": bson.A{
+			bson.M{"request.to": participant, "request.status": bson.M{"$ne": models.MessageRequestAccepted}},
+		},
+	}
+	if len(excludeUsernames) > 0 {
+		// groups are kept whoever else is in them
+		filter["$or"] = bson.A{
+			bson.M{"kind": models.ConversationKindGroup},
+			bson.M{"participants": bson.M{"$nin": excludeUsernames}},
+		}
+	}
+	return filter
+}
+
+// before matches the items sorted after the position by the time field then id, both descending.
+func before(field string, position ChatPosition) bson.A {
+	return bson.A{
+		bson.M{field: bson.M{"$lt": position.At}},
+		bson.M{field: position.At, "_id": bson.M{"$lt": position.Id}},
+	}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+type EndorsementRepository interface {
+	Endorse(ctx context.Context, endorsement models.Endorsement) (bool, error)
+	Unendorse(ctx context.Context, endorser string, endorsee string, skill string) (bool, error)
+	ListEndorsements(ctx context.Context, endorsee string, skill string, excludeEndorsers []string, page int64, pagesize int64) ([]models.Endorsement, error)
+}
+
+type endorsementRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewEndorsementRepository(m *imongo.Client, l *slog.Logger) EndorsementRepository {
+	return &endorsementRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	endorsementsCollectionName = "endorsements"
+)
+
+// Endorse saves the endorsement, overwriting the comment of an existing one. It reports whether the endorsement is new.
+func (r *endorsementRepositoryImpl) Endorse(ctx context.Context, endorsement models.Endorsement) (bool, error) {
+	now := time.Now()
+	filter := bson.M{"endorser": endorsement.Endorser, "endorsee": endorsement.Endorsee, "skill": endorsement.Skill}
+	update := bson.M{
+		"$set": bson.M{
+			"comment":    endorsement.Comment,
+			"updated_at": now,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": now,
+		},
+	}
+
+	result, err := r.mongo.Database.Collection(endorsementsCollectionName).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		r.logger.Error("failed to save endorsement", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	return result.UpsertedCount > 0, nil
+}
+
+// Unendorse removes the endorsement and reports whether there was one.
+func (r *endorsementRepositoryImpl) Unendorse(ctx context.Context, endorser string, endorsee string, skill string) (bool, error) {
+	filter := bson.M{"endorser": endorser, "endorsee": endorsee, "skill": skill}
+
+	result, err := r.mongo.Database.Collection(endorsementsCollectionName).DeleteOne(ctx, filter)
+	if err != nil {
+		r.logger.Error("failed to remove endorsement", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+// ListEndorsements lists the endorsements of the user, newest first. An empty skill matches every endorsement.
+func (r *endorsementRepositoryImpl) ListEndorsements(ctx context.Context, endorsee string, skill string, excludeEndorsers []string, page int64, pagesize int64) ([]models.Endorsement, error) {
+	filter := bson.M{"endorsee": endorsee}
+	if len(skill) > 0 {
+		filter["skill"] = skill
+	}
+	if len(excludeEndorsers) > 0 {
+		filter["endorser"] = bson.M{"$nin": excludeEndorsers}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetSkip(page * pagesize).SetLimit(pagesize)
+
+	cur, err := r.mongo.Database.Collection(endorsementsCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("failed to find in endorsements collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	endorsements := []models.Endorsement{}
+	if err := cur.All(ctx, &endorsements); err != nil {
+		r.logger.Error("failed to extract endorsements from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return endorsements, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+type FavouriteRepository interface {
+	SaveFavourite(ctx context.Context, favourite models.Favourite) error
+	RemoveFavourite(ctx context.Context, owner string, username string) error
+	ListFavourites(ctx context.Context, owner string, tag string, page int64, pagesize int64) ([]models.Favourite, error)
+	GetFavouritedUsernames(ctx context.Context, owner string, usernames []string) ([]string, error)
+}
+
+type favouriteRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewFavouriteRepository(m *imongo.Client, l *slog.Logger) FavouriteRepository {
+	return &favouriteRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	favouritesCollectionName = "favourites"
+)
+
+// SaveFavourite adds the user to the owner's favourites, or overwrites the note and tags if it is already there.
+func (r *favouriteRepositoryImpl) SaveFavourite(ctx context.Context, favourite models.Favourite) error {
+	now := time.Now()
+	filter := bson.M{"owner": favourite.Owner, "username": favourite.Username}
+	update := bson.M{
+		"$set": bson.M{
+			"note":       favourite.Note,
+			"tags":       favourite.Tags,
+			"updated_at": now,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": now,
+		},
+	}
+
+	_, err := r.mongo.Database.Collection(favouritesCollectionName).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		r.logger.Error("failed to save favourite", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *favouriteRepositoryImpl) RemoveFavourite(ctx context.Context, owner string, username string) error {
+	_, err := r.mongo.Database.Collection(favouritesCollectionName).DeleteOne(ctx, bson.M{"owner": owner, "username": username})
+	if err != nil {
+		r.logger.Error("failed to remove favourite", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// ListFavourites lists the owner's favourites, newest first. An empty tag matches every favourite.
+func (r *favouriteRepositoryImpl) ListFavourites(ctx context.Context, owner string, tag string, page int64, pagesize int64) ([]models.Favourite, error) {
+	filter := bson.M{"owner": owner}
+	if len(tag) > 0 {
+		filter["tags"] = tag
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetSkip(page * pagesize).SetLimit(pagesize)
+
+	cur, err := r.mongo.Database.Collection(favouritesCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("failed to find in favourites collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	favourites := []models.Favourite{}
+	if err := cur.All(ctx, &favourites); err != nil {
+		r.logger.Error("failed to extract favourites from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return favourites, nil
+}
+
+// GetFavouritedUsernames returns which of the given users are in the owner's favourites.
+func (r *favouriteRepositoryImpl) GetFavouritedUsernames(ctx context.Context, owner string, usernames []string) ([]string, error) {
+	if len(usernames) == 0 {
+		return []string{}, nil
+	}
+
+	filter := bson.M{"owner": owner, "username": bson.M{"$in": usernames}}
+	opts := options.Find().SetProjection(bson.M{"username": 1})
+
+	cur, err := r.mongo.Database.Collection(favouritesCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("failed to find in favourites collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	var favourites []models.Favourite
+	if err := cur.All(ctx, &favourites); err != nil {
+		r.logger.Error("failed to extract favourites from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	favourited := make([]string, len(favourites))
+	for i, favourite := range favourites {
+		favourited[i] = favourite.Username
+	}
+
+	return favourited, nil
+}
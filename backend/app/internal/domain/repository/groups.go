@@ -0,0 +1,308 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+var (
+	ErrInviteNotFound = errors.New("invite not found")
+	ErrGroupFull      = errors.New("group full")
+	ErrAlreadyMember  = errors.New("already a member")
+	ErrNotMember      = errors.New("not a member")
+	ErrMemberLimitLow = errors.New("member limit lower than the number of members")
+)
+
+// CircleSearchQuery describes the circles SearchCircles should look for.
+type CircleSearchQuery struct {
+	Skills   []string // Circles about one of these skills, any if empty.
+	After    *ChatPosition
+	Pagesize int64
+}
+
+// GroupRepository manages groups, which are stored as the conversations between their members.
+type GroupRepository interface {
+	CreateGroup(ctx context.Context, owner string, group models.Group) (*models.Conversation, error)
+	GetGroup(ctx context.Context, id primitive.ObjectID) (*models.Conversation, error)
+	FindGroupByInvite(ctx context.Context, code string) (*models.Conversation, error)
+	UpdateGroup(ctx context.Context, id primitive.ObjectID, group models.Group) (*models.Conversation, error)
+	AddInvite(ctx context.Context, id primitive.ObjectID, invite models.GroupInvite) error
+	AddMember(ctx context.Context, id primitive.ObjectID, username string) (*models.Conversation, error)
+	RemoveMember(ctx context.Context, id primitive.ObjectID, username string) error
+	SetMemberRole(ctx context.Context, id primitive.ObjectID, username string, role models.GroupRole) error
+	TransferOwnership(ctx context.Context, id primitive.ObjectID, from string, to string) error
+	SearchCircles(ctx context.Context, query CircleSearchQuery) (*ConversationPage, error)
+}
+
+type groupRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewGroupRepository(m *imongo.Client, l *slog.Logger) GroupRepository {
+	return &groupRepositoryImpl{mongo: m, logger: l}
+}
+
+// CreateGroup starts the group with its owner as its only member.
+func (r *groupRepositoryImpl) CreateGroup(ctx context.Context, owner string, group models.Group) (*models.Conversation, error) {
+	now := time.Now()
+	group.Members = []models.GroupMember{{Username: owner, Role: models.GroupRoleOwner, JoinedAt: now}}
+	group.Invites = []models.GroupInvite{}
+	conversation := models.Conversation{
+		Id:           primitive.NewObjectID(),
+		Kind:         models.ConversationKindGroup,
+		Participants: []string{owner},
+		Group:        &group,
+		Reads:        []models.ReadCursor{{Username: owner}},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	_, err := r.mongo.Database.Collection(conversationsCollectionName).InsertOne(ctx, conversation)
+	if err != nil {
+		r.logger.Error("failed to insert group", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &conversation, nil
+}
+
+// GetGroup gets the group whoever takes part in it.
+func (r *groupRepositoryImpl) GetGroup(ctx context.Context, id primitive.ObjectID) (*models.Conversation, error) {
+	return r.findGroup(ctx, bson.M{"_id": id, "kind": models.ConversationKindGroup}, ErrConversationNotFound)
+}
+
+// FindGroupByInvite gets the group the invite with the code is to, unless it expired.
+func (r *groupRepositoryImpl) FindGroupByInvite(ctx context.Context, code string) (*models.Conversation, error) {
+	filter := bson.M{
+		"kind":          models.ConversationKindGroup,
+		"group.invites": bson.M{"$elemMatch": bson.M{"code": code, "expires_at": bson.M{"$gt": time.Now()}}},
+	}
+	return r.findGroup(ctx, filter, ErrInviteNotFound)
+}
+
+func (r *groupRepositoryImpl) findGroup(ctx context.Context, filter bson.M, notFound error) (*models.Conversation, error) {
+	var conversation models.Conversation
+	err := r.mongo.Database.Collection(conversationsCollectionName).FindOne(ctx, filter).Decode(&conversation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, notFound
+		}
+		r.logger.Error("failed to find group", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &conversation, nil
+}
+
+// UpdateGroup changes the name, description, visibility and member limit of the group to those of group.
+func (r *groupRepositoryImpl) UpdateGroup(ctx context.Context, id primitive.ObjectID, group models.Group) (*models.Conversation, error) {
+	filter := bson.M{
+		"_id":   id,
+		"kind":  models.ConversationKindGroup,
+		"$expr": bson.M{"$lte": bson.A{bson.M{"$size": "$participants"}, group.MemberLimit}},
+	}
+	update := bson.M{"$set": bson.M{
+		"group.name":         group.Name,
+		"group.description":  group.Description,
+		"group.public":       group.Public,
+		"group.member_limit": group.MemberLimit,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var conversation models.Conversation
+	err := r.mongo.Database.Collection(conversationsCollectionName).FindOneAndUpdate(ctx, filter, update, opts).Decode(&conversation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			if _, err := r.GetGroup(ctx, id); err != nil {
+				return nil, err
+			}
+			return nil, ErrMemberLimitLow
+		}
+		r.logger.Error("failed to update group", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &conversation, nil
+}
+
+// AddInvite adds the invite to the group, dropping the expired ones.
+func (r *groupRepositoryImpl) AddInvite(ctx context.Context, id primitive.ObjectID, invite models.GroupInvite) error {
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"group.invites": bson.M{"$concatArrays": bson.A{
+			bson.M{"$filter": bson.M{
+				"input": "$group.invites",
+				"cond":  bson.M{"$gt": bson.A{"$$this.expires_at", time.Now()}},
+			}},
+			bson.A{bson.M{"$literal": invite}},
+		}}}}},
+	}
+	result, err := r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, bson.M{"_id": id, "kind": models.ConversationKindGroup}, update)
+	if err != nil {
+		r.logger.Error("failed to add invite", slog.Any("error", err))
+		return ErrInternal
+	}
+	if result.MatchedCount == 0 {
+		return ErrConversationNotFound
+	}
+
+	return nil
+}
+
+/*
+AddMember adds the user to the group as a member, unless it is full. Past messages count as read by them, so they don't
+join to hundreds of unread messages. Whoever joins a group left empty becomes its owner, so it is never without one.
+*/
+func (r *groupRepositoryImpl) AddMember(ctx context.Context, id primitive.ObjectID, username string) (*models.Conversation, error) {
+	now := time.Now()
+	filter := bson.M{
+		"_id":          id,
+		"kind":         models.ConversationKindGroup,
+		"participants": bson.M{"$ne": username},
+		"$expr":        bson.M{"$lt": bson.A{bson.M{"$size": "$participants"}, "$group.member_limit"}},
+	}
+	// usernames are taken literally, since they may start with $
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"participants": bson.M{"$concatArrays": bson.A{"$participants", bson.A{bson.M{"$literal": username}}}},
+			"reads": bson.M{"$concatArrays": bson.A{"$reads", bson.A{bson.M{
+				"username":   bson.M{"$literal": username},
+				"seq":        "$message_count",
+				"message_id": "$last_message._id",
+				"at":         now,
+			}}}},
+			"group.members": bson.M{"$concatArrays": bson.A{"$group.members", bson.A{bson.M{
+				"username":  bson.M{"$literal": username},
+				"role":      bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{bson.M{"$size": "$participants"}, 0}}, models.GroupRoleOwner, models.GroupRoleMember}},
+				"joined_at": now,
+			}}}},
+		}}},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var conversation models.Conversation
+	err := r.mongo.Database.Collection(conversationsCollectionName).FindOneAndUpdate(ctx, filter, update, opts).Decode(&conversation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			group, err := r.GetGroup(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := group.Group.Member(username); ok {
+				return nil, ErrAlreadyMember
+			}
+			return nil, ErrGroupFull
+		}
+		r.logger.Error("failed to add group member", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &conversation, nil
+}
+
+// RemoveMember removes the user from the group, along with their read cursor. Groups left empty are kept, unlisted.
+func (r *groupRepositoryImpl) RemoveMember(ctx context.Context, id primitive.ObjectID, username string) error {
+	filter := bson.M{"_id": id, "kind": models.ConversationKindGroup, "group.members.username": username}
+	update := bson.M{"$pull": bson.M{
+		"participants":  username,
+		"reads":         bson.M{"username": username},
+		"group.members": bson.M{"username": username},
+	}}
+	result, err := r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("failed to remove group member", slog.Any("error", err))
+		return ErrInternal
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotMember
+	}
+
+	return nil
+}
+
+// SetMemberRole makes the member an admin or a plain member. The owner's role only changes by transferring ownership.
+func (r *groupRepositoryImpl) SetMemberRole(ctx context.Context, id primitive.ObjectID, username string, role models.GroupRole) error {
+	filter := bson.M{
+		"_id":           id,
+		"kind":          models.ConversationKindGroup,
+		"group.members": bson.M{"$elemMatch": bson.M{"username": username, "role": bson.M{"$ne": models.GroupRoleOwner}}},
+	}
+	update := bson.M{"$set": bson.M{"group.members.$.role": role}}
+	result, err := r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("failed to set group member role", slog.Any("error", err))
+		return ErrInternal
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotMember
+	}
+
+	return nil
+}
+
+// TransferOwnership makes the member the owner of the group, the previous owner becoming an admin.
+func (r *groupRepositoryImpl) TransferOwnership(ctx context.Context, id primitive.ObjectID, from string, to string) error {
+	filter := bson.M{
+		"_id":  id,
+		"kind": models.ConversationKindGroup,
+		"$and": bson.A{
+			bson.M{"group.members": bson.M{"$elemMatch": bson.M{"username": from, "role": models.GroupRoleOwner}}},
+			bson.M{"group.members.username": to},
+		},
+	}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"group.members": bson.M{"$map": bson.M{
+			"input": "$group.members",
+			"in": bson.M{"$switch": bson.M{
+				"branches": bson.A{
+					bson.M{
+						"case": bson.M{"$eq": bson.A{"$$this.username", bson.M{"$literal": to}}},
+						"then": bson.M{"$mergeObjects": bson.A{"$$this", bson.M{"role": models.GroupRoleOwner}}},
+					},
+					bson.M{
+						"case": bson.M{"$eq": bson.A{"$$this.username", bson.M{"$literal": from}}},
+						"then": bson.M{"$mergeObjects": bson.A{"$$this", bson.M{"role": models.GroupRoleAdmin}}},
+					},
+				},
+				"default": "$$this",
+			}},
+		}}}}},
+	}
+	result, err := r.mongo.Database.Collection(conversationsCollectionName).UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.logger.Error("failed to transfer group ownership", slog.Any("error", err))
+		return ErrInternal
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotMember
+	}
+
+	return nil
+}
+
+// SearchCircles finds the public groups having members, most recently created first.
+func (r *groupRepositoryImpl) SearchCircles(ctx context.Context, query CircleSearchQuery) (*ConversationPage, error) {
+	filter := bson.M{
+		"kind":           models.ConversationKindGroup,
+		"group.public":   true,
+		"participants.0": bson.M{"$exists": true},
+	}
+	if len(query.Skills) > 0 {
+		filter["group.skill"] = bson.M{"$in": query.Skills}
+	}
+	if query.After != nil {
+		filter["$or"] = before("created_at", *query.After)
+	}
+
+	return findConversationPage(ctx, r.mongo, r.logger, filter, "created_at", query.Pagesize)
+}
@@ -0,0 +1,554 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+const (
+	migrationBatchSize = 1000
+)
+
+/*
+Migrations returns the migrations of the database, oldest first.
+Applied migrations must never be changed or removed, since they are only run once; add new ones instead.
+*/
+func Migrations() []imongo.Migration {
+	return []imongo.Migration{
+		{
+			Version:     1,
+			Description: "unique username index on users",
+			Up:          migrateUniqueUsernames,
+		},
+		{
+			Version:     2,
+			Description: "search indexes on users",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				return createIndexes(ctx, c, usersCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "teaching", Value: 1}}},
+					{Keys: bson.D{{Key: "learning", Value: 1}}},
+					{Keys: bson.D{{Key: "languages", Value: 1}}},
+					{Keys: bson.D{{Key: "endorsementcount", Value: -1}, {Key: "_id", Value: 1}}},
+					{Keys: bson.D{{Key: "lastactiveat", Value: -1}}},
+				})
+			},
+		},
+		{
+			Version:     3,
+			Description: "backfill user fields added after registration",
+			Up:          migrateBackfillUsers,
+		},
+		{
+			Version:     4,
+			Description: "unique indexes on blocks, favourites and endorsements",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				uniques := map[string]bson.D{
+					blocksCollectionName:       {{Key: "blocker", Value: 1}, {Key: "blocked", Value: 1}},
+					favouritesCollectionName:   {{Key: "owner", Value: 1}, {Key: "username", Value: 1}},
+					endorsementsCollectionName: {{Key: "endorser", Value: 1}, {Key: "endorsee", Value: 1}, {Key: "skill", Value: 1}},
+				}
+				for collection, keys := range uniques {
+					if err := removeDuplicates(ctx, c, collection, keys); err != nil {
+						return err
+					}
+				}
+
+				if err := createIndexes(ctx, c, blocksCollectionName, []mongo.IndexModel{
+					{Keys: uniques[blocksCollectionName], Options: options.Index().SetUnique(true)},
+					{Keys: bson.D{{Key: "blocker", Value: 1}, {Key: "created_at", Value: -1}}},
+					{Keys: bson.D{{Key: "blocked", Value: 1}}},
+				}); err != nil {
+					return err
+				}
+				if err := createIndexes(ctx, c, favouritesCollectionName, []mongo.IndexModel{
+					{Keys: uniques[favouritesCollectionName], Options: options.Index().SetUnique(true)},
+					{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "created_at", Value: -1}}},
+				}); err != nil {
+					return err
+				}
+				return createIndexes(ctx, c, endorsementsCollectionName, []mongo.IndexModel{
+					{Keys: uniques[endorsementsCollectionName], Options: options.Index().SetUnique(true)},
+					{Keys: bson.D{{Key: "endorsee", Value: 1}, {Key: "created_at", Value: -1}}},
+				})
+			},
+		},
+		{
+			Version:     5,
+			Description: "unique indexes on profile views",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				statsKeys := bson.D{{Key: "owner", Value: 1}, {Key: "day", Value: 1}}
+				viewersKeys := bson.D{{Key: "owner", Value: 1}, {Key: "viewer", Value: 1}, {Key: "day", Value: 1}}
+				if err := removeDuplicates(ctx, c, profileViewStatsCollectionName, statsKeys); err != nil {
+					return err
+				}
+				if err := removeDuplicates(ctx, c, profileViewersCollectionName, viewersKeys); err != nil {
+					return err
+				}
+
+				if err := createIndexes(ctx, c, profileViewStatsCollectionName, []mongo.IndexModel{
+					{Keys: statsKeys, Options: options.Index().SetUnique(true)},
+				}); err != nil {
+					return err
+				}
+				return createIndexes(ctx, c, profileViewersCollectionName, []mongo.IndexModel{
+					{Keys: viewersKeys, Options: options.Index().SetUnique(true)},
+					{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "last_viewed_at", Value: -1}}},
+					{Keys: bson.D{{Key: "viewer", Value: 1}}},
+				})
+			},
+		},
+		{
+			Version:     6,
+			Description: "indexes on saved searches and search alerts",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				if err := createIndexes(ctx, c, savedSearchesCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "created_at", Value: 1}}},
+					{Keys: bson.D{{Key: "skills", Value: 1}}},
+				}); err != nil {
+					return err
+				}
+				return createIndexes(ctx, c, searchAlertsCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "saved_search_id", Value: 1}, {Key: "username", Value: 1}}, Options: options.Index().SetUnique(true)},
+					{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "created_at", Value: -1}}},
+				})
+			},
+		},
+		{
+			Version:     7,
+			Description: "lowercase usernames and their trigrams for username search",
+			Up:          migrateUsernameSearch,
+		},
+		{
+			Version:     8,
+			Description: "sort indexes on users",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				// the endorsements one is created by the search indexes migration
+				if err := createIndexes(ctx, c, usersCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "createdat", Value: -1}, {Key: "_id", Value: 1}}},
+					{Keys: bson.D{{Key: "lastactiveat", Value: -1}, {Key: "_id", Value: 1}}},
+					{Keys: bson.D{{Key: "usernamelower", Value: 1}, {Key: "_id", Value: 1}}},
+				}); err != nil {
+					return err
+				}
+				// the last activity one of the search indexes migration is a prefix of its sort index, so it is dropped
+				_, err := c.Database.Collection(usersCollectionName).Indexes().DropOne(ctx, "lastactiveat_-1")
+				if err != nil && !isIndexNotFound(err) {
+					return fmt.Errorf("failed to drop last activity index: %w", err)
+				}
+				return nil
+			},
+		},
+		{
+			Version:     9,
+			Description: "indexes on conversations and messages",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				// participants are sorted, so a pair of users has a single conversation
+				if err := createIndexes(ctx, c, conversationsCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "participants.0", Value: 1}, {Key: "participants.1", Value: 1}}, Options: options.Index().SetUnique(true)},
+					{Keys: bson.D{{Key: "participants", Value: 1}, {Key: "updated_at", Value: -1}, {Key: "_id", Value: -1}}},
+				}); err != nil {
+					return err
+				}
+				return createIndexes(ctx, c, messagesCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "conversation_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+				})
+			},
+		},
+		{
+			Version:     10,
+			Description: "indexes on presence",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				return createIndexes(ctx, c, presenceCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "username", Value: 1}, {Key: "instance", Value: 1}}, Options: options.Index().SetUnique(true)},
+					{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+				})
+			},
+		},
+		{
+			Version:     11,
+			Description: "backfill message seqs and read cursors",
+			Up:          migrateReadCursors,
+		},
+		{
+			Version:     12,
+			Description: "index on message revisions",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				return createIndexes(ctx, c, messageRevisionsCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "message_id", Value: 1}, {Key: "revised_at", Value: 1}, {Key: "_id", Value: 1}}},
+				})
+			},
+		},
+		{
+			Version:     13,
+			Description: "conversation kinds and indexes on groups",
+			Up:          migrateConversationKinds,
+		},
+		{
+			Version:     14,
+			Description: "indexes on message requests and allowed senders",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				if err := createIndexes(ctx, c, allowedSendersCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "username", Value: 1}}, Options: options.Index().SetUnique(true)},
+					{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+				}); err != nil {
+					return err
+				}
+				requests := options.Index().SetPartialFilterExpression(bson.M{"request": bson.M{"$exists": true}})
+				return createIndexes(ctx, c, conversationsCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "request.to", Value: 1}, {Key: "request.status", Value: 1}, {Key: "updated_at", Value: -1}, {Key: "_id", Value: -1}}, Options: requests},
+					{Keys: bson.D{{Key: "request.from", Value: 1}, {Key: "request.created_at", Value: 1}}, Options: requests},
+				})
+			},
+		},
+		{
+			Version:     15,
+			Description: "moderation rules and flags",
+			Up:          migrateModeration,
+		},
+		{
+			Version:     16,
+			Description: "index on profile viewers by day",
+			Up: func(ctx context.Context, c *imongo.Client) error {
+				// profile view counters are recounted from the viewers of the owner's day
+				return createIndexes(ctx, c, profileViewersCollectionName, []mongo.IndexModel{
+					{Keys: bson.D{{Key: "owner", Value: 1}, {Key: "day", Value: 1}}},
+				})
+			},
+		},
+	}
+}
+
+/*
+migrateUniqueUsernames creates the unique username index. Duplicate usernames can't be resolved automatically,
+since either account may be the one in use, so they make the migration fail until they are dealt with by hand.
+*/
+func migrateUniqueUsernames(ctx context.Context, c *imongo.Client) error {
+	duplicates, err := findDuplicates(ctx, c, usersCollectionName, bson.D{{Key: "username", Value: 1}})
+	if err != nil {
+		return err
+	}
+	if len(duplicates) > 0 {
+		usernames := make([]any, len(duplicates))
+		for i, duplicate := range duplicates {
+			usernames[i] = duplicate.Key["username"]
+		}
+		return fmt.Errorf("duplicate usernames must be resolved first: %v", usernames)
+	}
+
+	return createIndexes(ctx, c, usersCollectionName, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "username", Value: 1}}, Options: options.Index().SetUnique(true)},
+	})
+}
+
+// migrateBackfillUsers sets the fields users registered before they existed are missing to their defaults.
+func migrateBackfillUsers(ctx context.Context, c *imongo.Client) error {
+	users := c.Database.Collection(usersCollectionName)
+
+	for _, field := range []string{"contacts", "languages", "skilllevels", "endorsements"} {
+		_, err := users.UpdateMany(ctx, bson.M{field: nil}, bson.M{"$set": bson.M{field: bson.A{}}})
+		if err != nil {
+			return fmt.Errorf("failed to backfill %s: %w", field, err)
+		}
+	}
+
+	_, err := users.UpdateMany(ctx, bson.M{"endorsementcount": nil}, mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"endorsementcount": bson.M{"$sum": bson.M{"$ifNull": bson.A{"$endorsements.count", bson.A{}}}}}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to backfill endorsementcount: %w", err)
+	}
+
+	_, err = users.UpdateMany(ctx, bson.M{"lastactiveat": nil}, mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"lastactiveat": "$createdat"}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to backfill lastactiveat: %w", err)
+	}
+
+	return nil
+}
+
+// migrateUsernameSearch derives the username search fields of the users registered before they existed, and indexes them.
+func migrateUsernameSearch(ctx context.Context, c *imongo.Client) error {
+	users := c.Database.Collection(usersCollectionName)
+
+	cur, err := users.Find(ctx, bson.M{"usernamelower": nil}, options.Find().SetProjection(bson.M{"username": 1}))
+	if err != nil {
+		return fmt.Errorf("failed to find users to backfill: %w", err)
+	}
+	defer cur.Close(ctx)
+
+	writes := []mongo.WriteModel{}
+	flush := func() error {
+		if len(writes) == 0 {
+			return nil
+		}
+		if _, err := users.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+			return fmt.Errorf("failed to backfill username search fields: %w", err)
+		}
+		writes = writes[:0]
+		return nil
+	}
+
+	for cur.Next(ctx) {
+		var user struct {
+			Id       any    `bson:"_id"`
+			Username string `bson:"username"`
+		}
+		if err := cur.Decode(&user); err != nil {
+			return fmt.Errorf("failed to decode user: %w", err)
+		}
+
+		lower := strings.ToLower(user.Username)
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": user.Id}).
+			SetUpdate(bson.M{"$set": bson.M{"usernamelower": lower, "usernametrigrams": trigrams(lower)}}))
+		if len(writes) == migrationBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return fmt.Errorf("failed to read users to backfill: %w", err)
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	return createIndexes(ctx, c, usersCollectionName, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "usernamelower", Value: 1}}},
+		{Keys: bson.D{{Key: "usernametrigrams", Value: 1}}},
+	})
+}
+
+/*
+migrateReadCursors numbers the messages of conversations started before unread counts existed, and marks them as read by
+both participants, rather than suddenly showing every past message as unread.
+*/
+func migrateReadCursors(ctx context.Context, c *imongo.Client) error {
+	conversations := c.Database.Collection(conversationsCollectionName)
+	messages := c.Database.Collection(messagesCollectionName)
+
+	cur, err := conversations.Find(ctx, bson.M{"message_count": nil}, options.Find().SetProjection(bson.M{"participants": 1}))
+	if err != nil {
+		return fmt.Errorf("failed to find conversations to backfill: %w", err)
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var conversation models.Conversation
+		if err := cur.Decode(&conversation); err != nil {
+			return fmt.Errorf("failed to decode conversation: %w", err)
+		}
+
+		opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).SetProjection(bson.M{"_id": 1})
+		messageCur, err := messages.Find(ctx, bson.M{"conversation_id": conversation.Id}, opts)
+		if err != nil {
+			return fmt.Errorf("failed to find messages to backfill: %w", err)
+		}
+		ids := []struct {
+			Id primitive.ObjectID `bson:"_id"`
+		}{}
+		if err := messageCur.All(ctx, &ids); err != nil {
+			return fmt.Errorf("failed to read messages to backfill: %w", err)
+		}
+
+		for start := 0; start < len(ids); start += migrationBatchSize {
+			writes := []mongo.WriteModel{}
+			for i := start; i < min(start+migrationBatchSize, len(ids)); i++ {
+				writes = append(writes, mongo.NewUpdateOneModel().
+					SetFilter(bson.M{"_id": ids[i].Id}).
+					SetUpdate(bson.M{"$set": bson.M{"seq": int64(i + 1)}}))
+			}
+			if _, err := messages.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+				return fmt.Errorf("failed to backfill message seqs: %w", err)
+			}
+		}
+
+		count := int64(len(ids))
+		reads := make([]models.ReadCursor, len(conversation.Participants))
+		for i, participant := range conversation.Participants {
+			reads[i] = models.ReadCursor{Username: participant}
+		}
+		set := bson.M{"message_count": count, "reads": reads}
+		if count > 0 {
+			for i := range reads {
+				reads[i].Seq, reads[i].MessageId, reads[i].At = count, ids[count-1].Id, time.Now()
+			}
+			set["last_message.seq"] = count
+		}
+		if _, err := conversations.UpdateOne(ctx, bson.M{"_id": conversation.Id}, bson.M{"$set": set}); err != nil {
+			return fmt.Errorf("failed to backfill read cursors: %w", err)
+		}
+	}
+	if err := cur.Err(); err != nil {
+		return fmt.Errorf("failed to read conversations to backfill: %w", err)
+	}
+
+	return nil
+}
+
+/*
+migrateConversationKinds marks the conversations started before groups existed as direct, and restricts the unique
+participants index to direct conversations, since groups may have the same first members. Every step is skipped once
+done, so it can be run again after being interrupted.
+*/
+func migrateConversationKinds(ctx context.Context, c *imongo.Client) error {
+	conversations := c.Database.Collection(conversationsCollectionName)
+
+	// only conversations without a kind are backfilled, so groups created since an interrupted run are left alone
+	_, err := conversations.UpdateMany(ctx, bson.M{"kind": nil}, bson.M{"$set": bson.M{"kind": models.ConversationKindDirect}})
+	if err != nil {
+		return fmt.Errorf("failed to backfill conversation kinds: %w", err)
+	}
+
+	// the direct index is created before the previous one is dropped, so pairs of users never go unchecked
+	participantsKeys := bson.D{{Key: "participants.0", Value: 1}, {Key: "participants.1", Value: 1}}
+	groups := bson.M{"kind": models.ConversationKindGroup}
+	if err := createIndexes(ctx, c, conversationsCollectionName, []mongo.IndexModel{
+		{
+			Keys: participantsKeys,
+			Options: options.Index().
+				SetName("direct_participants").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"kind": models.ConversationKindDirect}),
+		},
+		{
+			Keys:    bson.D{{Key: "group.public", Value: 1}, {Key: "group.skill", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetPartialFilterExpression(groups),
+		},
+		{
+			Keys:    bson.D{{Key: "group.invites.code", Value: 1}},
+			Options: options.Index().SetPartialFilterExpression(groups),
+		},
+	}); err != nil {
+		return err
+	}
+	_, err = conversations.Indexes().DropOne(ctx, "participants.0_1_participants.1_1")
+	if err != nil && !isIndexNotFound(err) {
+		return fmt.Errorf("failed to drop participants index: %w", err)
+	}
+
+	return nil
+}
+
+// isIndexNotFound reports whether the error is that of dropping an index that doesn't exist, e.g. dropped already.
+func isIndexNotFound(err error) bool {
+	var commandErr mongo.CommandError
+	return errors.As(err, &commandErr) && commandErr.Code == 27
+}
+
+/*
+migrateModeration creates the indexes of the moderation pipeline and seeds its default rules, unless rules were
+already set up by hand.
+*/
+func migrateModeration(ctx context.Context, c *imongo.Client) error {
+	if err := createIndexes(ctx, c, moderationRulesCollectionName, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "enabled", Value: 1}}},
+	}); err != nil {
+		return err
+	}
+	if err := createIndexes(ctx, c, moderationFlagsCollectionName, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "subject.username", Value: 1}, {Key: "created_at", Value: -1}}},
+	}); err != nil {
+		return err
+	}
+	// the spam checks count the messages of a sender within a window
+	if err := createIndexes(ctx, c, messagesCollectionName, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "sender", Value: 1}, {Key: "created_at", Value: -1}}},
+	}); err != nil {
+		return err
+	}
+
+	rules := c.Database.Collection(moderationRulesCollectionName)
+	count, err := rules.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("failed to count moderation rules: %w", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	now := time.Now()
+	messages := []models.ModerationSubjectKind{models.ModerationSubjectMessage}
+	defaults := []any{
+		models.ModerationRule{Kind: models.ModerationRuleLink, Action: models.ModerationActionFlag, Enabled: true, CreatedAt: now},
+		models.ModerationRule{Kind: models.ModerationRuleEmail, Action: models.ModerationActionFlag, Enabled: true, CreatedAt: now},
+		models.ModerationRule{Kind: models.ModerationRulePhone, Action: models.ModerationActionFlag, Enabled: true, CreatedAt: now},
+		models.ModerationRule{Kind: models.ModerationRuleRate, Limit: 30, Window: 60, Subjects: messages, Action: models.ModerationActionHide, Enabled: true, CreatedAt: now},
+		models.ModerationRule{Kind: models.ModerationRuleDuplicate, Limit: 10, Window: 600, Subjects: messages, Action: models.ModerationActionShadowRestrict, Enabled: true, CreatedAt: now},
+	}
+	if _, err := rules.InsertMany(ctx, defaults); err != nil {
+		return fmt.Errorf("failed to seed moderation rules: %w", err)
+	}
+
+	return nil
+}
+
+func createIndexes(ctx context.Context, c *imongo.Client, collection string, indexes []mongo.IndexModel) error {
+	_, err := c.Database.Collection(collection).Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("failed to create indexes on %s: %w", collection, err)
+	}
+	return nil
+}
+
+type duplicateGroup struct {
+	Key bson.M `bson:"_id"`
+	Ids bson.A `bson:"ids"`
+}
+
+// findDuplicates finds the groups of documents sharing the same values of keys, oldest first in each group.
+func findDuplicates(ctx context.Context, c *imongo.Client, collection string, keys bson.D) ([]duplicateGroup, error) {
+	group := bson.M{}
+	for _, key := range keys {
+		group[key.Key] = "$" + key.Key
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+		{{Key: "$group", Value: bson.M{"_id": group, "ids": bson.M{"$push": "$_id"}, "count": bson.M{"$sum": 1}}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	}
+
+	cur, err := c.Database.Collection(collection).Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicates in %s: %w", collection, err)
+	}
+	var duplicates []duplicateGroup
+	if err := cur.All(ctx, &duplicates); err != nil {
+		return nil, fmt.Errorf("failed to read duplicates in %s: %w", collection, err)
+	}
+	return duplicates, nil
+}
+
+/*
+removeDuplicates keeps only the oldest of the documents sharing the same values of keys, so a unique index can be created.
+Only meant for collections written with upserts, where duplicates are left over by concurrent writes of the same thing.
+*/
+func removeDuplicates(ctx context.Context, c *imongo.Client, collection string, keys bson.D) error {
+	duplicates, err := findDuplicates(ctx, c, collection, keys)
+	if err != nil {
+		return err
+	}
+
+	for _, duplicate := range duplicates {
+		_, err := c.Database.Collection(collection).DeleteMany(ctx, bson.M{"_id": bson.M{"$in": duplicate.Ids[1:]}})
+		if err != nil {
+			return fmt.Errorf("failed to remove duplicates in %s: %w", collection, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+type ModerationRepository interface {
+	ListEnabledRules(ctx context.Context) ([]models.ModerationRule, error)
+	CreateFlag(ctx context.Context, flag models.ModerationFlag) (*models.ModerationFlag, error)
+	ListFlags(ctx context.Context, page int64, pagesize int64) ([]models.ModerationFlag, error)
+}
+
+type moderationRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewModerationRepository(m *imongo.Client, l *slog.Logger) ModerationRepository {
+	return &moderationRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	moderationRulesCollectionName = "moderation_rules"
+	moderationFlagsCollectionName = "moderation_flags"
+)
+
+func (r *moderationRepositoryImpl) ListEnabledRules(ctx context.Context) ([]models.ModerationRule, error) {
+	cur, err := r.mongo.Database.Collection(moderationRulesCollectionName).Find(ctx, bson.M{"enabled": true}, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		r.logger.Error("failed to find in moderation rules collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	rules := []models.ModerationRule{}
+	if err := cur.All(ctx, &rules); err != nil {
+		r.logger.Error("failed to extract moderation rules from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return rules, nil
+}
+
+// CreateFlag records the flag, unless the same version of its subject was flagged already, returning the one recorded.
+func (r *moderationRepositoryImpl) CreateFlag(ctx context.Context, flag models.ModerationFlag) (*models.ModerationFlag, error) {
+	filter := bson.M{
+		"subject.kind":       flag.Subject.Kind,
+		"subject.username":   flag.Subject.Username,
+		"subject.message_id": bson.M{"$exists": false},
+		"at":                 flag.At,
+	}
+	insert := bson.M{
+		"_id":        primitive.NewObjectID(),
+		"text":       flag.Text,
+		"findings":   flag.Findings,
+		"action":     flag.Action,
+		"created_at": time.Now(),
+	}
+	if flag.Subject.MessageId != nil {
+		filter["subject.message_id"] = *flag.Subject.MessageId
+		insert["subject.conversation_id"] = flag.Subject.ConversationId
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var created models.ModerationFlag
+	err := r.mongo.Database.Collection(moderationFlagsCollectionName).FindOneAndUpdate(ctx, filter, bson.M{"$setOnInsert": insert}, opts).Decode(&created)
+	if err != nil {
+		r.logger.Error("failed to insert moderation flag", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &created, nil
+}
+
+// ListFlags lists the flags raised by the moderation pipeline, newest first.
+func (r *moderationRepositoryImpl) ListFlags(ctx context.Context, page int64, pagesize int64) ([]models.ModerationFlag, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetSkip(page * pagesize).SetLimit(pagesize)
+
+	cur, err := r.mongo.Database.Collection(moderationFlagsCollectionName).Find(ctx, bson.M{}, opts)
+	if err != nil {
+		r.logger.Error("failed to find in moderation flags collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	flags := []models.ModerationFlag{}
+	if err := cur.All(ctx, &flags); err != nil {
+		r.logger.Error("failed to extract moderation flags from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return flags, nil
+}
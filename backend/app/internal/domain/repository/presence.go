@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+)
+
+/*
+PresenceRepository records which instances of the app users are connected to for real-time events.
+Records expire unless refreshed, so that those of an instance that crashed don't keep its users online forever.
+*/
+type PresenceRepository interface {
+	MarkConnected(ctx context.Context, username string, instance string, expiresAt time.Time) (bool, error)
+	MarkDisconnected(ctx context.Context, username string, instance string) (bool, error)
+	RefreshConnected(ctx context.Context, usernames []string, instance string, expiresAt time.Time) error
+}
+
+type presenceRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewPresenceRepository(m *imongo.Client, l *slog.Logger) PresenceRepository {
+	return &presenceRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	presenceCollectionName = "presence"
+)
+
+// MarkConnected records the user as connected to the instance, and returns whether they weren't connected anywhere before.
+func (r *presenceRepositoryImpl) MarkConnected(ctx context.Context, username string, instance string, expiresAt time.Time) (bool, error) {
+	presence := r.mongo.Database.Collection(presenceCollectionName)
+	filter := bson.M{"username": username, "instance": instance}
+
+	_, err := presence.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"expires_at": expiresAt}}, options.Update().SetUpsert(true))
+	if err != nil {
+		r.logger.Error("failed to mark user connected", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	count, err := presence.CountDocuments(ctx, bson.M{"username": username})
+	if err != nil {
+		r.logger.Error("failed to count user connections", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	return count == 1, nil
+}
+
+// MarkDisconnected records the user as no longer connected to the instance, and returns whether they aren't connected anywhere now.
+func (r *presenceRepositoryImpl) MarkDisconnected(ctx context.Context, username string, instance string) (bool, error) {
+	presence := r.mongo.Database.Collection(presenceCollectionName)
+
+	_, err := presence.DeleteOne(ctx, bson.M{"username": username, "instance": instance})
+	if err != nil {
+		r.logger.Error("failed to mark user disconnected", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	count, err := presence.CountDocuments(ctx, bson.M{"username": username})
+	if err != nil {
+		r.logger.Error("failed to count user connections", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	return count == 0, nil
+}
+
+func (r *presenceRepositoryImpl) RefreshConnected(ctx context.Context, usernames []string, instance string, expiresAt time.Time) error {
+	if len(usernames) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"username": bson.M{"$in": usernames}, "instance": instance}
+	_, err := r.mongo.Database.Collection(presenceCollectionName).UpdateMany(ctx, filter, bson.M{"$set": bson.M{"expires_at": expiresAt}})
+	if err != nil {
+		r.logger.Error("failed to refresh connected users", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
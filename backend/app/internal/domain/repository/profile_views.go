@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+type ProfileViewRepository interface {
+	RecordView(ctx context.Context, eventId string, view models.ProfileViewer) error
+	GetDailyStats(ctx context.Context, owner string, since string) ([]models.ProfileViewStats, error)
+	ListRecentViewers(ctx context.Context, owner string, since string, excludeViewers []string, limit int64) ([]models.ProfileViewer, error)
+	UnlistViewer(ctx context.Context, viewer string) error
+}
+
+type profileViewRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewProfileViewRepository(m *imongo.Client, l *slog.Logger) ProfileViewRepository {
+	return &profileViewRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	profileViewStatsCollectionName = "profile_view_stats"
+	profileViewersCollectionName   = "profile_viewers"
+)
+
+/*
+RecordView counts a single view of the owner's profile in the daily counters.
+The viewer is counted as unique the first time they view the profile that day. The viewer keeps the ids of the view
+events counted, and the day's counters are recounted from the viewers, so recording an event again changes nothing.
+*/
+func (r *profileViewRepositoryImpl) RecordView(ctx context.Context, eventId string, view models.ProfileViewer) error {
+	filter := bson.M{"owner": view.Owner, "viewer": view.Viewer, "day": view.Day, "event_ids": bson.M{"$ne": eventId}}
+	update := bson.M{
+		"$inc":  bson.M{"views": 1},
+		"$max":  bson.M{"last_viewed_at": view.LastViewedAt},
+		"$set":  bson.M{"listed": view.Listed},
+		"$push": bson.M{"event_ids": eventId},
+	}
+
+	// a viewer who already has the event fails the upsert on the unique viewer index, having been counted
+	_, err := r.mongo.Database.Collection(profileViewersCollectionName).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		r.logger.Error("failed to record profile viewer", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	// recounting also catches up on the counters of a previous try that failed after counting the viewer
+	cur, err := r.mongo.Database.Collection(profileViewersCollectionName).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"owner": view.Owner, "day": view.Day}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":            nil,
+			"views":          bson.M{"$sum": "$views"},
+			"unique_viewers": bson.M{"$sum": 1},
+		}}},
+	})
+	if err != nil {
+		r.logger.Error("failed to count profile viewers", slog.Any("error", err))
+		return ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	var counters []models.ProfileViewStats
+	if err := cur.All(ctx, &counters); err != nil || len(counters) == 0 {
+		r.logger.Error("failed to extract profile view counters from cursor", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	_, err = r.mongo.Database.Collection(profileViewStatsCollectionName).UpdateOne(ctx,
+		bson.M{"owner": view.Owner, "day": view.Day},
+		bson.M{"$set": bson.M{"views": counters[0].Views, "unique_viewers": counters[0].UniqueViewers}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		r.logger.Error("failed to update profile view stats", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// GetDailyStats returns the owner's counters for the days since the given one, oldest first. Days without views are missing.
+func (r *profileViewRepositoryImpl) GetDailyStats(ctx context.Context, owner string, since string) ([]models.ProfileViewStats, error) {
+	filter := bson.M{"owner": owner, "day": bson.M{"$gte": since}}
+	opts := options.Find().SetSort(bson.D{{Key: "day", Value: 1}})
+
+	cur, err := r.mongo.Database.Collection(profileViewStatsCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("failed to find in profile view stats collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	stats := []models.ProfileViewStats{}
+	if err := cur.All(ctx, &stats); err != nil {
+		r.logger.Error("failed to extract profile view stats from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return stats, nil
+}
+
+// ListRecentViewers returns the listed viewers of the owner's profile since the given day, most recent first.
+func (r *profileViewRepositoryImpl) ListRecentViewers(ctx context.Context, owner string, since string, excludeViewers []string, limit int64) ([]models.ProfileViewer, error) {
+	filter := bson.M{"owner": owner, "day": bson.M{"$gte": since}, "listed": true}
+	if len(excludeViewers) > 0 {
+		filter["viewer"] = bson.M{"$nin": excludeViewers}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "last_viewed_at", Value: -1}, {Key: "_id", Value: -1}}).SetLimit(limit)
+
+	cur, err := r.mongo.Database.Collection(profileViewersCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("failed to find in profile viewers collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	viewers := []models.ProfileViewer{}
+	if err := cur.All(ctx, &viewers); err != nil {
+		r.logger.Error("failed to extract profile viewers from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return viewers, nil
+}
+
+// UnlistViewer removes the viewer from the viewers lists of every profile they have viewed. The counters are kept.
+func (r *profileViewRepositoryImpl) UnlistViewer(ctx context.Context, viewer string) error {
+	_, err := r.mongo.Database.Collection(profileViewersCollectionName).UpdateMany(ctx, bson.M{"viewer": viewer}, bson.M{"$set": bson.M{"listed": false}})
+	if err != nil {
+		r.logger.Error("failed to unlist profile viewer", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+type RecommendationRepository interface {
+	SaveRecommendations(ctx context.Context, recommendations models.Recommendations) error
+	GetRecommendations(ctx context.Context, owner string) (*models.Recommendations, error)
+	RemoveRecommendedUser(ctx context.Context, username string) error
+	AddRecommendedUser(ctx context.Context, byOwner map[string]models.Recommendation, limit int) error
+}
+
+type recommendationRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewRecommendationRepository(m *imongo.Client, l *slog.Logger) RecommendationRepository {
+	return &recommendationRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	recommendationsCollectionName = "recommendations"
+)
+
+// SaveRecommendations replaces all of the owner's recommendations.
+func (r *recommendationRepositoryImpl) SaveRecommendations(ctx context.Context, recommendations models.Recommendations) error {
+	_, err := r.mongo.Database.Collection(recommendationsCollectionName).ReplaceOne(ctx, bson.M{"_id": recommendations.Owner}, recommendations, options.Replace().SetUpsert(true))
+	if err != nil {
+		r.logger.Error("failed to save recommendations", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// GetRecommendations returns the owner's recommendations, or nil if none were computed yet.
+func (r *recommendationRepositoryImpl) GetRecommendations(ctx context.Context, owner string) (*models.Recommendations, error) {
+	var recommendations models.Recommendations
+	err := r.mongo.Database.Collection(recommendationsCollectionName).FindOne(ctx, bson.M{"_id": owner}).Decode(&recommendations)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		r.logger.Error("failed to find recommendations", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &recommendations, nil
+}
+
+// RemoveRecommendedUser removes the user from everyone's recommendations.
+func (r *recommendationRepositoryImpl) RemoveRecommendedUser(ctx context.Context, username string) error {
+	_, err := r.mongo.Database.Collection(recommendationsCollectionName).UpdateMany(ctx,
+		bson.M{"items.username": username},
+		bson.M{"$pull": bson.M{"items": bson.M{"username": username}}},
+	)
+	if err != nil {
+		r.logger.Error("failed to remove recommended user", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+/*
+AddRecommendedUser puts a user in the recommendations of each owner, keeping the limit best ones of each.
+The user must have been removed from them beforehand.
+*/
+func (r *recommendationRepositoryImpl) AddRecommendedUser(ctx context.Context, byOwner map[string]models.Recommendation, limit int) error {
+	if len(byOwner) == 0 {
+		return nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(byOwner))
+	for owner, recommendation := range byOwner {
+		// $literal keeps skills starting with $ from being taken for field paths
+		items := bson.M{"$concatArrays": bson.A{bson.M{"$ifNull": bson.A{"$items", bson.A{}}}, bson.A{bson.M{"$literal": recommendation}}}}
+		update := mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"items": bson.M{"$slice": bson.A{
+					bson.M{"$sortArray": bson.M{"input": items, "sortBy": bson.D{{Key: "score", Value: -1}, {Key: "username", Value: 1}}}},
+					limit,
+				}},
+			}}},
+		}
+		writes = append(writes, mongo.NewUpdateOneModel().SetFilter(bson.M{"_id": owner}).SetUpdate(update).SetUpsert(true))
+	}
+
+	_, err := r.mongo.Database.Collection(recommendationsCollectionName).BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		r.logger.Error("failed to add recommended user", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
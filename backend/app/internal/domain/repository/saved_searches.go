@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+)
+
+var ErrSavedSearchNotFound = errors.New("saved search not found")
+
+type SavedSearchRepository interface {
+	CreateSavedSearch(ctx context.Context, search models.SavedSearch) (*models.SavedSearch, error)
+	CountSavedSearches(ctx context.Context, owner string) (int64, error)
+	ListSavedSearches(ctx context.Context, owner string) ([]models.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, owner string, id primitive.ObjectID) error
+	FindCandidateSavedSearches(ctx context.Context, user models.User) ([]models.SavedSearch, error)
+	RecordAlert(ctx context.Context, alert models.SearchAlert) (bool, error)
+	ListAlerts(ctx context.Context, owner string, unreadOnly bool, page int64, pagesize int64) ([]models.SearchAlert, error)
+	MarkAlertsRead(ctx context.Context, owner string) error
+}
+
+type savedSearchRepositoryImpl struct {
+	mongo  *imongo.Client
+	logger *slog.Logger
+}
+
+func NewSavedSearchRepository(m *imongo.Client, l *slog.Logger) SavedSearchRepository {
+	return &savedSearchRepositoryImpl{mongo: m, logger: l}
+}
+
+const (
+	savedSearchesCollectionName = "saved_searches"
+	searchAlertsCollectionName  = "search_alerts"
+)
+
+func (r *savedSearchRepositoryImpl) CreateSavedSearch(ctx context.Context, search models.SavedSearch) (*models.SavedSearch, error) {
+	now := time.Now()
+	search.Id = primitive.NewObjectID()
+	search.CreatedAt = now
+	search.UpdatedAt = now
+
+	_, err := r.mongo.Database.Collection(savedSearchesCollectionName).InsertOne(ctx, search)
+	if err != nil {
+		r.logger.Error("failed to insert saved search", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return &search, nil
+}
+
+func (r *savedSearchRepositoryImpl) CountSavedSearches(ctx context.Context, owner string) (int64, error) {
+	count, err := r.mongo.Database.Collection(savedSearchesCollectionName).CountDocuments(ctx, bson.M{"owner": owner})
+	if err != nil {
+		r.logger.Error("failed to count saved searches", slog.Any("error", err))
+		return 0, ErrInternal
+	}
+
+	return count, nil
+}
+
+// ListSavedSearches lists the owner's saved searches, oldest first.
+func (r *savedSearchRepositoryImpl) ListSavedSearches(ctx context.Context, owner string) ([]models.SavedSearch, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cur, err := r.mongo.Database.Collection(savedSearchesCollectionName).Find(ctx, bson.M{"owner": owner}, opts)
+	if err != nil {
+		r.logger.Error("failed to find in saved searches collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	searches := []models.SavedSearch{}
+	if err := cur.All(ctx, &searches); err != nil {
+		r.logger.Error("failed to extract saved searches from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return searches, nil
+}
+
+// DeleteSavedSearch deletes the owner's saved search along with its alerts.
+func (r *savedSearchRepositoryImpl) DeleteSavedSearch(ctx context.Context, owner string, id primitive.ObjectID) error {
+	res, err := r.mongo.Database.Collection(savedSearchesCollectionName).DeleteOne(ctx, bson.M{"_id": id, "owner": owner})
+	if err != nil {
+		r.logger.Error("failed to delete saved search", slog.Any("error", err))
+		return ErrInternal
+	}
+	if res.DeletedCount == 0 {
+		return ErrSavedSearchNotFound
+	}
+
+	_, err = r.mongo.Database.Collection(searchAlertsCollectionName).DeleteMany(ctx, bson.M{"saved_search_id": id})
+	if err != nil {
+		r.logger.Error("failed to delete search alerts", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+/*
+FindCandidateSavedSearches finds the saved searches of other users the user might match, judging by skills and languages only.
+Candidates still have to be checked against the full search.
+*/
+func (r *savedSearchRepositoryImpl) FindCandidateSavedSearches(ctx context.Context, user models.User) ([]models.SavedSearch, error) {
+	filter := bson.M{
+		"owner": bson.M{"$ne": user.Username},
+		"$and": bson.A{
+			bson.M{"$or": bson.A{
+				bson.M{"skills": bson.M{"$size": 0}},
+				bson.M{"skills": bson.M{"$in": user.Teaching}},
+				bson.M{"skills": bson.M{"$in": user.Learning}, "mode": UserSearchModeLearners},
+			}},
+			bson.M{"$or": bson.A{
+				bson.M{"languages": bson.M{"$size": 0}},
+				bson.M{"languages": bson.M{"$in": user.Languages}},
+			}},
+		},
+	}
+
+	cur, err := r.mongo.Database.Collection(savedSearchesCollectionName).Find(ctx, filter)
+	if err != nil {
+		r.logger.Error("failed to find in saved searches collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	searches := []models.SavedSearch{}
+	if err := cur.All(ctx, &searches); err != nil {
+		r.logger.Error("failed to extract saved searches from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return searches, nil
+}
+
+// RecordAlert records the alert unless the user already matched the saved search before, and returns whether it was created.
+func (r *savedSearchRepositoryImpl) RecordAlert(ctx context.Context, alert models.SearchAlert) (bool, error) {
+	filter := bson.M{"saved_search_id": alert.SavedSearchId, "username": alert.Username}
+	update := bson.M{
+		"$setOnInsert": bson.M{
+			"owner":             alert.Owner,
+			"saved_search_name": alert.SavedSearchName,
+			"read":              false,
+			"created_at":        alert.CreatedAt,
+		},
+	}
+
+	res, err := r.mongo.Database.Collection(searchAlertsCollectionName).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		r.logger.Error("failed to record search alert", slog.Any("error", err))
+		return false, ErrInternal
+	}
+
+	return res.UpsertedCount > 0, nil
+}
+
+// ListAlerts lists the owner's alerts, newest first.
+func (r *savedSearchRepositoryImpl) ListAlerts(ctx context.Context, owner string, unreadOnly bool, page int64, pagesize int64) ([]models.SearchAlert, error) {
+	filter := bson.M{"owner": owner}
+	if unreadOnly {
+		filter["read"] = false
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).SetSkip(page * pagesize).SetLimit(pagesize)
+
+	cur, err := r.mongo.Database.Collection(searchAlertsCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("failed to find in search alerts collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	alerts := []models.SearchAlert{}
+	if err := cur.All(ctx, &alerts); err != nil {
+		r.logger.Error("failed to extract search alerts from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return alerts, nil
+}
+
+func (r *savedSearchRepositoryImpl) MarkAlertsRead(ctx context.Context, owner string) error {
+	_, err := r.mongo.Database.Collection(searchAlertsCollectionName).UpdateMany(ctx, bson.M{"owner": owner, "read": false}, bson.M{"$set": bson.M{"read": true}})
+	if err != nil {
+		r.logger.Error("failed to mark search alerts read", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
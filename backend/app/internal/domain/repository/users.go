@@ -4,8 +4,14 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
@@ -15,12 +21,123 @@ import (
 
 type UserRepository interface {
 	GetUserByUsername(ctx context.Context, username string) (*models.User, error)
+	GetUsersByUsernames(ctx context.Context, usernames []string) ([]models.User, error)
+	ListUsers(ctx context.Context) ([]models.User, error)
 	CreateUser(ctx context.Context, user models.User) error
 	UpdateUser(ctx context.Context, user models.User) error
 	DeleteUser(ctx context.Context, user models.User) error
-	SearchUsers(ctx context.Context, excludeUsername string, usernameSubstring string, learning []string, teaching []string, page int64, pagesize int64) ([]models.User, error)
+	AddContact(ctx context.Context, username string, contact string) error
+	RemoveContact(ctx context.Context, username string, contact string) error
+	AddEndorsements(ctx context.Context, username string, skill string, delta int64) error
+	TouchUser(ctx context.Context, username string, at time.Time) error
+	SetBioHidden(ctx context.Context, username string, hidden bool) error
+	ShadowRestrict(ctx context.Context, username string) error
+	SearchUsers(ctx context.Context, query UserSearchQuery) (*UserSearchPage, error)
+	CountUsers(ctx context.Context, query UserSearchQuery, limit int64) (int64, error)
+	GetSearchFacets(ctx context.Context, query UserSearchQuery, size int64) (*UserSearchFacets, error)
+	MatchUsers(ctx context.Context, query UserMatchQuery) ([]UserMatch, error)
+	EnsureIndexes(ctx context.Context) error
 }
 
+// UserSearchQuery describes the users SearchUsers should look for.
+type UserSearchQuery struct {
+	ExcludeUsername  string   // The searching user. Also used to resolve profiles restricted to contacts.
+	Username         string   // Users whose username starts with this, ignoring case.
+	UsernameInfix    bool     // Match Username anywhere in usernames instead, if at least 3 characters long.
+	Learning         []string // Users learning at least one of these skills.
+	Teaching         []string // Users teaching at least one of these skills.
+	Languages        []string // Users speaking at least one of these languages.
+	Text             string   // Free text looked up in the text index over bios and skills.
+	TextStems        []string // Stems of the Text terms, which users hiding their bio or learning must match in a field they show.
+	ExcludeUsernames []string // Users that must never be returned, e.g. blocked ones.
+	OnlyUsername     string   // Only this user, to check whether a single user matches the search.
+	Sort             UserSearchSort
+	After            *UserSearchPosition // Position of the last user of the previous page, takes precedence over Page.
+	Page             int64
+	Pagesize         int64
+}
+
+// UserSearchPosition is where a page of search results ends, so the next one can continue from there.
+type UserSearchPosition struct {
+	Id        primitive.ObjectID `bson:"id"`
+	SortValue bson.RawValue      `bson:"value"`  // Value of the sort key of the last user, for sorts having one.
+	Offset    int64              `bson:"offset"` // Number of users before the next page, for sorts that can only be resumed by skipping.
+}
+
+// UserSearchFacets counts the most common values of the fields of the users matching a search.
+type UserSearchFacets struct {
+	Teaching  []FacetCount `bson:"teaching"`
+	Learning  []FacetCount `bson:"learning"`
+	Languages []FacetCount `bson:"languages"`
+}
+
+type FacetCount struct {
+	Value string `bson:"_id"`
+	Count int64  `bson:"count"`
+}
+
+// UserSearchPage is a page of search results.
+type UserSearchPage struct {
+	Users   []models.User
+	HasMore bool
+	Next    UserSearchPosition // Only set if HasMore.
+}
+
+// UserMatchQuery describes whom MatchUsers should find skill swap partners for.
+type UserMatchQuery struct {
+	Username         string
+	Teaching         []string
+	Learning         []string
+	ExcludeUsernames []string // Users that must never be returned, e.g. blocked ones.
+	Page             int64
+	Pagesize         int64
+}
+
+// UserMatch is a user found by MatchUsers along with how well they match.
+type UserMatch struct {
+	User         models.User `bson:"user"`
+	Score        float64     `bson:"score"`
+	TeachesMe    []string    `bson:"teaches_me"`
+	LearnsFromMe []string    `bson:"learns_from_me"`
+}
+
+type UserSearchMode string
+
+const (
+	UserSearchModeExchange UserSearchMode = "exchange" // Users learning what the searcher teaches and teaching the skills, if any.
+	UserSearchModeTeachers UserSearchMode = "teachers" // Users teaching the skills, or what the searcher learns if none.
+	UserSearchModeLearners UserSearchMode = "learners" // Users learning the skills, or what the searcher teaches if none.
+)
+
+// SkillFilters returns the Learning and Teaching filters of a search in the mode, by the searcher for the skills.
+func SkillFilters(mode UserSearchMode, searcher models.User, skills []string) (learning []string, teaching []string) {
+	switch mode {
+	case UserSearchModeTeachers:
+		if len(skills) == 0 {
+			return nil, searcher.Learning
+		}
+		return nil, skills
+	case UserSearchModeLearners:
+		if len(skills) == 0 {
+			return searcher.Teaching, nil
+		}
+		return skills, nil
+	default:
+		return searcher.Teaching, skills
+	}
+}
+
+type UserSearchSort string
+
+const (
+	UserSearchSortDefault        UserSearchSort = ""
+	UserSearchSortNewest         UserSearchSort = "newest"          // Most recently registered users first.
+	UserSearchSortRecentlyActive UserSearchSort = "recently_active" // Most recently active users first.
+	UserSearchSortEndorsements   UserSearchSort = "endorsements"    // Most endorsed users first.
+	UserSearchSortAlphabetical   UserSearchSort = "alphabetical"    // By username, ignoring case.
+	UserSearchSortRelevance      UserSearchSort = "relevance"       // Best text matches first, requires Text.
+)
+
 type userRepositoryImpl struct {
 	mongo  *imongo.Client
 	logger *slog.Logger
@@ -32,9 +149,11 @@ func NewUserRepository(m *imongo.Client, l *slog.Logger) UserRepository {
 
 var ErrUserNotFound = errors.New("user not found")
 var ErrInternal = errors.New("internal error")
+var ErrUserAlreadyExists = errors.New("user already exists")
 
 const (
 	usersCollectionName = "users"
+	usersTextIndexName  = "users_text"
 )
 
 func (r *userRepositoryImpl) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
@@ -57,9 +176,56 @@ func (r *userRepositoryImpl) GetUserByUsername(ctx context.Context, username str
 	return &user, nil
 }
 
+// GetUsersByUsernames returns the existing users among the given usernames, in no particular order.
+func (r *userRepositoryImpl) GetUsersByUsernames(ctx context.Context, usernames []string) ([]models.User, error) {
+	if len(usernames) == 0 {
+		return []models.User{}, nil
+	}
+
+	cur, err := r.mongo.Database.Collection(usersCollectionName).Find(ctx, bson.M{"username": bson.M{"$in": usernames}})
+	if err != nil {
+		r.logger.Error("failed to find in users collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	users := []models.User{}
+	if err := cur.All(ctx, &users); err != nil {
+		r.logger.Error("failed to extract users from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return users, nil
+}
+
+// ListUsers returns every user. Meant for offline jobs only, since it loads the whole collection.
+func (r *userRepositoryImpl) ListUsers(ctx context.Context) ([]models.User, error) {
+	cur, err := r.mongo.Database.Collection(usersCollectionName).Find(ctx, bson.M{})
+	if err != nil {
+		r.logger.Error("failed to find in users collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	users := []models.User{}
+	if err := cur.All(ctx, &users); err != nil {
+		r.logger.Error("failed to extract users from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return users, nil
+}
+
 func (r *userRepositoryImpl) CreateUser(ctx context.Context, user models.User) error {
+	user.UsernameLower = strings.ToLower(user.Username)
+	user.UsernameTrigrams = trigrams(user.UsernameLower)
+
 	_, err := r.mongo.Database.Collection(usersCollectionName).InsertOne(ctx, user)
 	if err != nil {
+		// the unique username index settles concurrent registrations of the same username
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrUserAlreadyExists
+		}
 		r.logger.Error("failed to create user", slog.Any("error", err))
 		return ErrInternal
 	}
@@ -67,8 +233,26 @@ func (r *userRepositoryImpl) CreateUser(ctx context.Context, user models.User) e
 	return nil
 }
 
+/*
+UpdateUser overwrites the editable profile fields of the user.
+Fields managed by dedicated methods (e.g. contacts) are left untouched so concurrent updates don't clobber them.
+*/
 func (r *userRepositoryImpl) UpdateUser(ctx context.Context, user models.User) error {
-	_, err := r.mongo.Database.Collection(usersCollectionName).UpdateOne(ctx, bson.M{"_id": user.Id}, bson.M{"$set": user})
+	update := bson.M{
+		"$set": bson.M{
+			"password":    user.Password,
+			"email":       user.Email,
+			"bio":         user.Bio,
+			"teaching":    user.Teaching,
+			"learning":    user.Learning,
+			"languages":   user.Languages,
+			"skilllevels": user.SkillLevels,
+			"privacy":     user.Privacy,
+			"updatedat":   user.UpdatedAt,
+		},
+	}
+
+	_, err := r.mongo.Database.Collection(usersCollectionName).UpdateOne(ctx, bson.M{"_id": user.Id}, update)
 	if err != nil {
 		r.logger.Error("failed to update user", slog.Any("error", err))
 		return ErrInternal
@@ -87,29 +271,141 @@ func (r *userRepositoryImpl) DeleteUser(ctx context.Context, user models.User) e
 	return nil
 }
 
+func (r *userRepositoryImpl) AddContact(ctx context.Context, username string, contact string) error {
+	_, err := r.mongo.Database.Collection(usersCollectionName).UpdateOne(ctx, bson.M{"username": username}, bson.M{"$addToSet": bson.M{"contacts": contact}})
+	if err != nil {
+		r.logger.Error("failed to add contact", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+func (r *userRepositoryImpl) RemoveContact(ctx context.Context, username string, contact string) error {
+	_, err := r.mongo.Database.Collection(usersCollectionName).UpdateOne(ctx, bson.M{"username": username}, bson.M{"$pull": bson.M{"contacts": contact}})
+	if err != nil {
+		r.logger.Error("failed to remove contact", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
 /*
-SearchUsers searches for users by username that are teaching at least one of the given skills and learning at least one of the given skills.
+AddEndorsements adjusts the denormalized endorsement counters of the user by delta.
+Skills are kept in an array rather than a map since skill names may contain dots.
 */
-func (r *userRepositoryImpl) SearchUsers(ctx context.Context, excludeUsername string, usernameSubstring string, learning []string, teaching []string, page int64, pagesize int64) ([]models.User, error) {
-	filter := bson.M{}
+func (r *userRepositoryImpl) AddEndorsements(ctx context.Context, username string, skill string, delta int64) error {
+	collection := r.mongo.Database.Collection(usersCollectionName)
 
-	if len(excludeUsername) > 0 {
-		filter["username"] = bson.M{"$ne": excludeUsername}
+	// the skill is either counted already or has to be pushed; retry once in case a concurrent push wins
+	for range 2 {
+		result, err := collection.UpdateOne(ctx,
+			bson.M{"username": username, "endorsements.skill": skill},
+			bson.M{"$inc": bson.M{"endorsements.$.count": delta, "endorsementcount": delta}},
+		)
+		if err != nil {
+			r.logger.Error("failed to update endorsements", slog.Any("error", err))
+			return ErrInternal
+		}
+		if result.MatchedCount > 0 {
+			break
+		}
+
+		// pipeline update, so users stored before endorsements existed (null array) are handled too
+		result, err = collection.UpdateOne(ctx,
+			bson.M{"username": username, "endorsements.skill": bson.M{"$ne": skill}},
+			bson.A{bson.M{"$set": bson.M{
+				"endorsements": bson.M{"$concatArrays": bson.A{
+					bson.M{"$ifNull": bson.A{"$endorsements", bson.A{}}},
+					bson.A{models.SkillEndorsements{Skill: skill, Count: delta}},
+				}},
+				"endorsementcount": bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$endorsementcount", 0}}, delta}},
+			}}},
+		)
+		if err != nil {
+			r.logger.Error("failed to update endorsements", slog.Any("error", err))
+			return ErrInternal
+		}
+		if result.MatchedCount > 0 {
+			break
+		}
 	}
 
-	if len(usernameSubstring) > 0 {
-		filter["username"] = bson.M{"$regex": usernameSubstring, "$options": "i"}
+	if delta < 0 {
+		_, err := collection.UpdateOne(ctx, bson.M{"username": username}, bson.M{"$pull": bson.M{"endorsements": bson.M{"count": bson.M{"$lte": 0}}}})
+		if err != nil {
+			r.logger.Error("failed to clean up endorsements", slog.Any("error", err))
+			return ErrInternal
+		}
 	}
 
-	if len(learning) > 0 {
-		filter["learning"] = bson.M{"$in": learning}
+	return nil
+}
+
+// TouchUser records that the user has been active at the given time, used to rank recently active users higher.
+func (r *userRepositoryImpl) TouchUser(ctx context.Context, username string, at time.Time) error {
+	_, err := r.mongo.Database.Collection(usersCollectionName).UpdateOne(ctx, bson.M{"username": username}, bson.M{"$max": bson.M{"lastactiveat": at}})
+	if err != nil {
+		r.logger.Error("failed to touch user", slog.Any("error", err))
+		return ErrInternal
 	}
 
-	if len(teaching) > 0 {
-		filter["teaching"] = bson.M{"$in": teaching}
+	return nil
+}
+
+// SetBioHidden hides or shows the bio of the user to others, on behalf of moderation.
+func (r *userRepositoryImpl) SetBioHidden(ctx context.Context, username string, hidden bool) error {
+	_, err := r.mongo.Database.Collection(usersCollectionName).UpdateOne(ctx, bson.M{"username": username}, bson.M{"$set": bson.M{"biohidden": hidden}})
+	if err != nil {
+		r.logger.Error("failed to set bio hidden", slog.Any("error", err))
+		return ErrInternal
 	}
 
-	opts := options.Find().SetSkip(page * pagesize).SetLimit(pagesize)
+	return nil
+}
+
+// ShadowRestrict hides the user's bio and the messages they send from then on from everyone but them.
+func (r *userRepositoryImpl) ShadowRestrict(ctx context.Context, username string) error {
+	update := bson.M{"$set": bson.M{"shadowrestricted": true, "biohidden": true}}
+	_, err := r.mongo.Database.Collection(usersCollectionName).UpdateOne(ctx, bson.M{"username": username}, update)
+	if err != nil {
+		r.logger.Error("failed to shadow restrict user", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
+}
+
+/*
+SearchUsers searches for users by username that are teaching at least one of the given skills and learning at least one of the given skills.
+Users hidden from search are skipped, as are users whose profile is restricted to contacts unless the searching user is one of them.
+Pages continue from query.After if it is set, or start at query.Page otherwise.
+*/
+func (r *userRepositoryImpl) SearchUsers(ctx context.Context, query UserSearchQuery) (*UserSearchPage, error) {
+	filter := searchFilter(query)
+	field, direction := userSearchSortKey(query.Sort)
+
+	skip := query.Page * query.Pagesize
+	if query.After != nil {
+		skip = 0
+		if query.Sort == UserSearchSortRelevance {
+			skip = query.After.Offset
+		} else {
+			filter["$and"] = append(filter["$and"].(bson.A), after(field, direction, *query.After))
+		}
+	}
+
+	// one extra user tells whether there is a next page
+	opts := options.Find().SetSkip(skip).SetLimit(query.Pagesize + 1)
+	switch {
+	case query.Sort == UserSearchSortRelevance:
+		opts.SetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "_id", Value: 1}})
+	case len(field) > 0:
+		opts.SetSort(bson.D{{Key: field, Value: direction}, {Key: "_id", Value: 1}})
+	default:
+		opts.SetSort(bson.D{{Key: "_id", Value: 1}})
+	}
 
 	cur, err := r.mongo.Database.Collection(usersCollectionName).Find(ctx, filter, opts)
 	if err != nil {
@@ -119,11 +415,427 @@ func (r *userRepositoryImpl) SearchUsers(ctx context.Context, excludeUsername st
 	}
 	defer cur.Close(ctx)
 
-	var users []models.User
-	if err := cur.All(ctx, &users); err != nil {
+	var docs []bson.Raw
+	if err := cur.All(ctx, &docs); err != nil {
 		r.logger.Error("failed to extract users from cursor", slog.Any("error", err))
 		return nil, ErrInternal
 	}
 
-	return users, nil
+	page := &UserSearchPage{Users: []models.User{}}
+	if int64(len(docs)) > query.Pagesize {
+		page.HasMore = true
+		docs = docs[:query.Pagesize]
+	}
+
+	for _, doc := range docs {
+		var user models.User
+		if err := bson.Unmarshal(doc, &user); err != nil {
+			r.logger.Error("failed to decode user", slog.Any("error", err))
+			return nil, ErrInternal
+		}
+		page.Users = append(page.Users, user)
+	}
+
+	if page.HasMore {
+		last := docs[len(docs)-1]
+		page.Next = UserSearchPosition{
+			Id:     page.Users[len(page.Users)-1].Id,
+			Offset: skip + int64(len(docs)),
+		}
+		if len(field) > 0 {
+			value, err := last.LookupErr(field)
+			if err != nil {
+				// missing values sort like null
+				value = bson.RawValue{Type: bsontype.Null}
+			}
+			page.Next.SortValue = value
+		}
+	}
+
+	return page, nil
+}
+
+// CountUsers counts the users SearchUsers would find for the query, stopping at limit.
+func (r *userRepositoryImpl) CountUsers(ctx context.Context, query UserSearchQuery, limit int64) (int64, error) {
+	count, err := r.mongo.Database.Collection(usersCollectionName).CountDocuments(ctx, searchFilter(query), options.Count().SetLimit(limit))
+	if err != nil {
+		r.logger.Error("failed to count users", slog.Any("error", err))
+		return 0, ErrInternal
+	}
+
+	return count, nil
+}
+
+/*
+GetSearchFacets counts the skills and languages of the users SearchUsers would find for the query, keeping the size most common of each.
+Learning skills of users hiding them are left out.
+*/
+func (r *userRepositoryImpl) GetSearchFacets(ctx context.Context, query UserSearchQuery, size int64) (*UserSearchFacets, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: searchFilter(query)}},
+		{{Key: "$facet", Value: bson.M{
+			"teaching":  facetCounts("teaching", size),
+			"learning":  append(bson.A{bson.M{"$match": bson.M{"privacy.hide_learning": bson.M{"$ne": true}}}}, facetCounts("learning", size)...),
+			"languages": facetCounts("languages", size),
+		}}},
+	}
+
+	cur, err := r.mongo.Database.Collection(usersCollectionName).Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("failed to aggregate users collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	// $facet always outputs a single document
+	facets := &UserSearchFacets{}
+	if cur.Next(ctx) {
+		if err := cur.Decode(facets); err != nil {
+			r.logger.Error("failed to decode facets", slog.Any("error", err))
+			return nil, ErrInternal
+		}
+	}
+	if err := cur.Err(); err != nil {
+		r.logger.Error("failed to read facets from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return facets, nil
+}
+
+// facetCounts returns the stages counting the most common values of the array field.
+func facetCounts(field string, size int64) bson.A {
+	return bson.A{
+		bson.M{"$unwind": "$" + field},
+		bson.M{"$group": bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}},
+		bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+		bson.M{"$limit": size},
+	}
+}
+
+func searchFilter(query UserSearchQuery) bson.M {
+	filter := bson.M{}
+
+	if len(query.ExcludeUsername) > 0 {
+		filter["username"] = bson.M{"$ne": query.ExcludeUsername}
+	}
+
+	if len(query.Learning) > 0 {
+		filter["learning"] = bson.M{"$in": query.Learning}
+	}
+
+	if len(query.Teaching) > 0 {
+		filter["teaching"] = bson.M{"$in": query.Teaching}
+	}
+
+	if len(query.Languages) > 0 {
+		filter["languages"] = bson.M{"$in": query.Languages}
+	}
+
+	if len(query.Text) > 0 {
+		filter["$text"] = bson.M{"$search": query.Text}
+	}
+
+	// username conditions go to $and so they don't overwrite the exclusion of the searching user
+	conditions := discoverableBy(query.ExcludeUsername, query.ExcludeUsernames)
+	if len(query.Username) > 0 {
+		conditions = append(conditions, usernameMatch(query.Username, query.UsernameInfix)...)
+	}
+	if len(query.OnlyUsername) > 0 {
+		conditions = append(conditions, bson.M{"username": query.OnlyUsername})
+	}
+	if len(query.Text) > 0 {
+		conditions = append(conditions, visibleTextMatch(query.TextStems))
+	}
+	filter["$and"] = conditions
+
+	return filter
+}
+
+/*
+visibleTextMatch returns the condition keeping the users found by the text index only if they match in a field they
+show, since the index covers hidden fields too. Stems are matched as word prefixes, as highlights are.
+*/
+func visibleTextMatch(stems []string) bson.M {
+	nothingHidden := bson.M{
+		"privacy.hide_bio":      bson.M{"$ne": true},
+		"biohidden":             bson.M{"$ne": true},
+		"privacy.hide_learning": bson.M{"$ne": true},
+	}
+	if len(stems) == 0 {
+		return nothingHidden
+	}
+
+	quoted := make([]string, len(stems))
+	for i, stem := range stems {
+		quoted[i] = regexp.QuoteMeta(stem)
+	}
+	words := primitive.Regex{Pattern: `(?:^|[^\p{L}\p{Nd}])(?:` + strings.Join(quoted, "|") + `)`, Options: "i"}
+
+	return bson.M{"$or": bson.A{
+		nothingHidden,
+		bson.M{"teaching": words},
+		bson.M{"bio": words, "privacy.hide_bio": bson.M{"$ne": true}, "biohidden": bson.M{"$ne": true}},
+		bson.M{"learning": words, "privacy.hide_learning": bson.M{"$ne": true}},
+	}}
+}
+
+/*
+usernameMatch returns the conditions matching usernames containing the searched one, ignoring case.
+The search is escaped so it is always matched literally. Prefixes are matched with an anchored regex on the lowercase
+username, which is bounded by its index. Infixes narrow down candidates with the trigram index first, and the shorter
+ones having no trigram are matched as prefixes.
+*/
+func usernameMatch(username string, infix bool) bson.A {
+	lower := strings.ToLower(username)
+	pattern := regexp.QuoteMeta(lower)
+
+	grams := trigrams(lower)
+	if !infix || len(grams) == 0 {
+		return bson.A{bson.M{"usernamelower": bson.M{"$regex": "^" + pattern}}}
+	}
+
+	return bson.A{
+		bson.M{"usernametrigrams": bson.M{"$all": grams}},
+		bson.M{"usernamelower": bson.M{"$regex": pattern}},
+	}
+}
+
+// trigrams returns the distinct sequences of 3 characters of the text.
+func trigrams(text string) []string {
+	runes := []rune(text)
+	grams := []string{}
+	for i := 0; i+3 <= len(runes); i++ {
+		gram := string(runes[i : i+3])
+		if !slices.Contains(grams, gram) {
+			grams = append(grams, gram)
+		}
+	}
+	return grams
+}
+
+// userSearchSortKey returns the field and direction users are sorted by before _id, if any.
+func userSearchSortKey(sort UserSearchSort) (string, int) {
+	switch sort {
+	case UserSearchSortNewest:
+		return "createdat", -1
+	case UserSearchSortRecentlyActive:
+		return "lastactiveat", -1
+	case UserSearchSortEndorsements:
+		return "endorsementcount", -1
+	case UserSearchSortAlphabetical:
+		return "usernamelower", 1
+	default:
+		return "", 0
+	}
+}
+
+/*
+after matches the users coming after the position in the order given by the sort key and _id.
+Null and missing values sort before all others but are never matched by comparisons, so they get branches of their
+own: they come last in descending order, and first in ascending order.
+*/
+func after(field string, direction int, position UserSearchPosition) bson.M {
+	if len(field) == 0 {
+		return bson.M{"_id": bson.M{"$gt": position.Id}}
+	}
+
+	if position.SortValue.Type == 0 || position.SortValue.Type == bsontype.Null {
+		branches := bson.A{bson.M{field: nil, "_id": bson.M{"$gt": position.Id}}}
+		if direction > 0 {
+			branches = append(branches, bson.M{field: bson.M{"$ne": nil}})
+		}
+		return bson.M{"$or": branches}
+	}
+
+	comparison := "$gt"
+	if direction < 0 {
+		comparison = "$lt"
+	}
+
+	branches := bson.A{
+		bson.M{field: bson.M{comparison: position.SortValue}},
+		bson.M{field: position.SortValue, "_id": bson.M{"$gt": position.Id}},
+	}
+	if direction < 0 {
+		branches = append(branches, bson.M{field: nil})
+	}
+	return bson.M{"$or": branches}
+}
+
+// discoverableBy returns the conditions users must meet to be found by the viewer in search-like listings.
+func discoverableBy(viewer string, excludeUsernames []string) bson.A {
+	conditions := bson.A{
+		bson.M{"privacy.hidden_from_search": bson.M{"$ne": true}},
+		bson.M{"$or": bson.A{
+			bson.M{"privacy.profile_visibility": bson.M{"$in": bson.A{nil, "", models.AudienceEveryone}}},
+			bson.M{"privacy.profile_visibility": models.AudienceContacts, "contacts": viewer},
+		}},
+	}
+
+	if len(excludeUsernames) > 0 {
+		conditions = append(conditions, bson.M{"username": bson.M{"$nin": excludeUsernames}})
+	}
+
+	return conditions
+}
+
+// Weights of the parts of the match score, adding up to 1.
+const (
+	matchOverlapWeight  = 0.6
+	matchLevelWeight    = 0.25
+	matchRecencyWeight  = 0.15
+	matchNeutralLevel   = 3   // Assumed level of skills the user hasn't rated.
+	matchRecencyDays    = 7   // Days of inactivity that halve the recency part of the score.
+	matchInactivityDays = 365 // Assumed inactivity of users without any activity recorded.
+)
+
+/*
+MatchUsers finds users teaching at least one skill the user learns and learning at least one skill the user teaches.
+Matches are ranked by a score from 0 to 1 computed in the pipeline from:
+  - the share of the user's learning and teaching skills covered by the match, both ways;
+  - the match's level in the skills they would teach, if rated;
+  - how recently the match has been active.
+*/
+func (r *userRepositoryImpl) MatchUsers(ctx context.Context, query UserMatchQuery) ([]UserMatch, error) {
+	if len(query.Teaching) == 0 || len(query.Learning) == 0 {
+		return []UserMatch{}, nil
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"username": bson.M{"$ne": query.Username},
+			"teaching": bson.M{"$in": query.Learning},
+			"learning": bson.M{"$in": query.Teaching},
+			"$and":     discoverableBy(query.Username, query.ExcludeUsernames),
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"teaches_me":     bson.M{"$setIntersection": bson.A{"$teaching", query.Learning}},
+			"learns_from_me": bson.M{"$setIntersection": bson.A{"$learning", query.Teaching}},
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"overlap_score": bson.M{"$divide": bson.A{
+				bson.M{"$add": bson.A{
+					bson.M{"$divide": bson.A{bson.M{"$size": "$teaches_me"}, len(query.Learning)}},
+					bson.M{"$divide": bson.A{bson.M{"$size": "$learns_from_me"}, len(query.Teaching)}},
+				}},
+				2,
+			}},
+			"level_score": bson.M{"$divide": bson.A{
+				bson.M{"$ifNull": bson.A{
+					bson.M{"$avg": bson.M{"$map": bson.M{
+						"input": bson.M{"$filter": bson.M{
+							"input": bson.M{"$ifNull": bson.A{"$skilllevels", bson.A{}}},
+							"as":    "level",
+							"cond":  bson.M{"$in": bson.A{"$$level.skill", "$teaches_me"}},
+						}},
+						"as": "level",
+						"in": "$$level.level",
+					}}},
+					matchNeutralLevel,
+				}},
+				models.MaxSkillLevel,
+			}},
+			"recency_score": bson.M{"$divide": bson.A{
+				1,
+				bson.M{"$add": bson.A{
+					1,
+					bson.M{"$divide": bson.A{
+						bson.M{"$ifNull": bson.A{
+							bson.M{"$dateDiff": bson.M{
+								"startDate": bson.M{"$ifNull": bson.A{"$lastactiveat", "$createdat"}},
+								"endDate":   "$$NOW",
+								"unit":      "day",
+							}},
+							matchInactivityDays,
+						}},
+						matchRecencyDays,
+					}},
+				}},
+			}},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":            0,
+			"user":           "$$ROOT",
+			"teaches_me":     1,
+			"learns_from_me": 1,
+			"score": bson.M{"$add": bson.A{
+				bson.M{"$multiply": bson.A{matchOverlapWeight, "$overlap_score"}},
+				bson.M{"$multiply": bson.A{matchLevelWeight, "$level_score"}},
+				bson.M{"$multiply": bson.A{matchRecencyWeight, "$recency_score"}},
+			}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "score", Value: -1}, {Key: "user._id", Value: 1}}}},
+		{{Key: "$skip", Value: query.Page * query.Pagesize}},
+		{{Key: "$limit", Value: query.Pagesize}},
+	}
+
+	cur, err := r.mongo.Database.Collection(usersCollectionName).Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("failed to aggregate users collection", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	matches := []UserMatch{}
+	if err := cur.All(ctx, &matches); err != nil {
+		r.logger.Error("failed to extract matches from cursor", slog.Any("error", err))
+		return nil, ErrInternal
+	}
+
+	return matches, nil
+}
+
+/*
+EnsureIndexes creates the text index, which depends on configuration rather than on the schema and so is checked at
+every startup instead of being a migration.
+*/
+func (r *userRepositoryImpl) EnsureIndexes(ctx context.Context) error {
+	return ensureTextIndex(ctx, r.mongo, r.logger, usersCollectionName, mongo.IndexModel{
+		Keys: bson.D{{Key: "bio", Value: "text"}, {Key: "teaching", Value: "text"}, {Key: "learning", Value: "text"}},
+		Options: options.Index().
+			SetName(usersTextIndexName).
+			SetWeights(bson.D{{Key: "teaching", Value: 5}, {Key: "learning", Value: 3}, {Key: "bio", Value: 1}}),
+	})
+}
+
+/*
+ensureTextIndex creates the text index in the configured text search language, recreating it when the language
+changes since an existing one can't be altered. The index must be named.
+*/
+func ensureTextIndex(ctx context.Context, m *imongo.Client, logger *slog.Logger, collectionName string, index mongo.IndexModel) error {
+	collection := m.Database.Collection(collectionName)
+	language := m.TextSearchLanguage()
+	name := *index.Options.Name
+
+	cur, err := collection.Indexes().List(ctx)
+	if err != nil {
+		logger.Error("failed to list indexes", slog.String("collection", collectionName), slog.Any("error", err))
+		return ErrInternal
+	}
+	defer cur.Close(ctx)
+
+	var indexes []bson.M
+	if err := cur.All(ctx, &indexes); err != nil {
+		logger.Error("failed to extract indexes from cursor", slog.Any("error", err))
+		return ErrInternal
+	}
+
+	for _, existing := range indexes {
+		if existing["name"] == name && existing["default_language"] != language {
+			logger.Info("text search language changed, recreating text index", slog.String("index", name), slog.Any("old", existing["default_language"]), slog.String("new", language))
+			if _, err := collection.Indexes().DropOne(ctx, name); err != nil {
+				logger.Error("failed to drop text index", slog.Any("error", err))
+				return ErrInternal
+			}
+		}
+	}
+
+	index.Options.SetDefaultLanguage(language)
+	if _, err := collection.Indexes().CreateOne(ctx, index); err != nil {
+		logger.Error("failed to create text index", slog.String("index", name), slog.Any("error", err))
+		return ErrInternal
+	}
+
+	return nil
 }
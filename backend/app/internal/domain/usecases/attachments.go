@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"mime"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	MaxAttachmentSize      = 10 << 20        // 10 MiB, as documented in the API.
+	AttachmentUploadWindow = 5 * time.Minute // How long upload URLs are valid, the file being final once checked.
+)
+
+// Types attachments may be of, as detected from their content. Office and OpenDocument files are detected as zip.
+var attachmentContentTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"text/plain",
+	"text/xml",
+	"application/zip",
+}
+
+// AttachmentContentType detects the type of a file from its content, and whether attachments may be of that type.
+func AttachmentContentType(data []byte) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil {
+		return "", false
+	}
+	return mediaType, slices.Contains(attachmentContentTypes, mediaType)
+}
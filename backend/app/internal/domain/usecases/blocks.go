@@ -0,0 +1,30 @@
+package usecases
+
+import (
+	"context"
+
+	"skilly/internal/domain/repository"
+)
+
+/*
+CanInteract reports whether two users are allowed to interact, i.e. neither of them has blocked the other.
+Every endpoint letting one user reach another must check it.
+*/
+func CanInteract(ctx context.Context, blockRepo repository.BlockRepository, first string, second string) (bool, error) {
+	blocked, err := blockRepo.IsBlocked(ctx, first, second)
+	if err != nil {
+		return false, err
+	}
+	return !blocked, nil
+}
+
+// BlockUser blocks the user and drops them from the blocker's contacts, and the blocker from theirs.
+func BlockUser(ctx context.Context, userRepo repository.UserRepository, blockRepo repository.BlockRepository, blocker string, blocked string) error {
+	if err := blockRepo.Block(ctx, blocker, blocked); err != nil {
+		return err
+	}
+	if err := userRepo.RemoveContact(ctx, blocker, blocked); err != nil {
+		return err
+	}
+	return userRepo.RemoveContact(ctx, blocked, blocker)
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"context"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+)
+
+// Endorse saves the endorsement and counts it on the endorsed user unless it only updates the comment of an existing one.
+func Endorse(ctx context.Context, userRepo repository.UserRepository, endorsementRepo repository.EndorsementRepository, endorsement models.Endorsement) error {
+	created, err := endorsementRepo.Endorse(ctx, endorsement)
+	if err != nil {
+		return err
+	}
+	if !created {
+		return nil
+	}
+	return userRepo.AddEndorsements(ctx, endorsement.Endorsee, endorsement.Skill, 1)
+}
+
+// Unendorse withdraws the endorsement and uncounts it on the endorsed user.
+func Unendorse(ctx context.Context, userRepo repository.UserRepository, endorsementRepo repository.EndorsementRepository, endorser string, endorsee string, skill string) error {
+	removed, err := endorsementRepo.Unendorse(ctx, endorser, endorsee, skill)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return nil
+	}
+	return userRepo.AddEndorsements(ctx, endorsee, skill, -1)
+}
@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"skilly/internal/domain/models"
+)
+
+const (
+	inviteCodeSize = 16
+)
+
+// CanManageGroup reports whether a member with the role may change the group's settings and invite users to it.
+func CanManageGroup(role models.GroupRole) bool {
+	return role == models.GroupRoleOwner || role == models.GroupRoleAdmin
+}
+
+// CanRemoveMember reports whether a member with the role may remove a member with the other role from the group.
+func CanRemoveMember(role models.GroupRole, other models.GroupRole) bool {
+	switch role {
+	case models.GroupRoleOwner:
+		return other != models.GroupRoleOwner
+	case models.GroupRoleAdmin:
+		return other == models.GroupRoleMember
+	default:
+		return false
+	}
+}
+
+// NewInviteCode returns a random code for an invite to a group, too long to be guessed.
+func NewInviteCode() (string, error) {
+	code := make([]byte, inviteCodeSize)
+	if _, err := rand.Read(code); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(code), nil
+}
@@ -0,0 +1,199 @@
+package usecases
+
+import (
+	"strings"
+	"unicode"
+
+	"skilly/internal/domain/models"
+)
+
+const (
+	HighlightFieldBio      = "bio"
+	HighlightFieldTeaching = "teaching"
+	HighlightFieldLearning = "learning"
+)
+
+const (
+	highlightSnippetLength = 160 // Characters of a bio or message shown around the first match.
+	highlightSnippetLead   = 40  // Characters shown before the first match.
+	highlightMinTermLength = 2   // Shorter terms would highlight about every word.
+	highlightMinStemLength = 4
+	highlightEllipsis      = "…"
+)
+
+// Highlight is a part of a profile field matching a text search query.
+type Highlight struct {
+	Field  string
+	Text   string
+	Ranges []HighlightRange
+}
+
+// HighlightRange marks the characters [Start, End) of a highlight's text as matching.
+type HighlightRange struct {
+	Start int
+	End   int
+}
+
+/*
+HighlightUser finds the parts of the user's bio and skills matching the text search query.
+Words are matched by prefix on a crude stem of the query terms, which roughly follows the stemming of the text index
+whatever its language. Negated terms are ignored and quoted phrases are matched word by word.
+*/
+func HighlightUser(user models.User, query string) []Highlight {
+	terms := HighlightTerms(query)
+	if len(terms) == 0 {
+		return []Highlight{}
+	}
+
+	highlights := []Highlight{}
+
+	if ranges := matchTerms(user.Bio, terms); len(ranges) > 0 {
+		text, ranges := snippet(user.Bio, ranges)
+		highlights = append(highlights, Highlight{Field: HighlightFieldBio, Text: text, Ranges: ranges})
+	}
+	for _, skill := range user.Teaching {
+		if ranges := matchTerms(skill, terms); len(ranges) > 0 {
+			highlights = append(highlights, Highlight{Field: HighlightFieldTeaching, Text: skill, Ranges: ranges})
+		}
+	}
+	for _, skill := range user.Learning {
+		if ranges := matchTerms(skill, terms); len(ranges) > 0 {
+			highlights = append(highlights, Highlight{Field: HighlightFieldLearning, Text: skill, Ranges: ranges})
+		}
+	}
+
+	return highlights
+}
+
+/*
+HighlightText finds the parts of the text, such as that of a message, matching the text search query like HighlightUser,
+cutting it down around the first one. Texts found by the text index for stems the query terms miss have no ranges.
+*/
+func HighlightText(text string, query string) (string, []HighlightRange) {
+	terms := HighlightTerms(query)
+	ranges := []HighlightRange{}
+	if len(terms) > 0 {
+		ranges = matchTerms(text, terms)
+	}
+	return snippet(text, ranges)
+}
+
+// VisibleHighlights drops the highlights of the fields the user, or moderation, hides from the viewer.
+func VisibleHighlights(highlights []Highlight, user models.User, viewer string) []Highlight {
+	if user.Username == viewer {
+		return highlights
+	}
+
+	visible := []Highlight{}
+	for _, highlight := range highlights {
+		if highlight.Field == HighlightFieldBio && (user.Privacy.HideBio || user.BioHidden) {
+			continue
+		}
+		if highlight.Field == HighlightFieldLearning && user.Privacy.HideLearning {
+			continue
+		}
+		visible = append(visible, highlight)
+	}
+	return visible
+}
+
+// HighlightTerms returns the stems of the query terms worth highlighting, matched as word prefixes.
+func HighlightTerms(query string) []string {
+	stems := []string{}
+	for _, term := range strings.Fields(query) {
+		if strings.HasPrefix(term, "-") {
+			continue
+		}
+		for _, word := range splitWords(strings.ToLower(term)) {
+			if len(word.runes) < highlightMinTermLength {
+				continue
+			}
+			stems = append(stems, stem(string(word.runes)))
+		}
+	}
+	return stems
+}
+
+func stem(word string) string {
+	runes := []rune(word)
+	length := max(highlightMinStemLength, len(runes)-2)
+	if length >= len(runes) {
+		return word
+	}
+	return string(runes[:length])
+}
+
+type word struct {
+	runes []rune
+	start int
+}
+
+// splitWords splits the text into runs of letters and digits, keeping their offsets in characters.
+func splitWords(text string) []word {
+	words := []word{}
+	current := word{start: -1}
+	for i, r := range []rune(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if current.start < 0 {
+				current.start = i
+			}
+			current.runes = append(current.runes, r)
+			continue
+		}
+		if current.start >= 0 {
+			words = append(words, current)
+			current = word{start: -1}
+		}
+	}
+	if current.start >= 0 {
+		words = append(words, current)
+	}
+	return words
+}
+
+func matchTerms(text string, stems []string) []HighlightRange {
+	ranges := []HighlightRange{}
+	for _, w := range splitWords(text) {
+		lower := strings.ToLower(string(w.runes))
+		for _, s := range stems {
+			if strings.HasPrefix(lower, s) {
+				ranges = append(ranges, HighlightRange{Start: w.start, End: w.start + len(w.runes)})
+				break
+			}
+		}
+	}
+	return ranges
+}
+
+// snippet cuts the text down around the first match, or to its start without any, shifting the ranges to the cut text.
+func snippet(text string, ranges []HighlightRange) (string, []HighlightRange) {
+	runes := []rune(text)
+	if len(runes) <= highlightSnippetLength {
+		return text, ranges
+	}
+
+	start := 0
+	if len(ranges) > 0 {
+		start = max(0, ranges[0].Start-highlightSnippetLead)
+	}
+	end := min(len(runes), start+highlightSnippetLength)
+	start = max(0, end-highlightSnippetLength)
+
+	prefix, suffix := "", ""
+	if start > 0 {
+		prefix = highlightEllipsis
+	}
+	if end < len(runes) {
+		suffix = highlightEllipsis
+	}
+	shift := len([]rune(prefix)) - start
+
+	shifted := []HighlightRange{}
+	for _, r := range ranges {
+		if r.Start >= start && r.End <= end {
+			shifted = append(shifted, HighlightRange{Start: r.Start + shift, End: r.End + shift})
+		}
+	}
+
+	return prefix + string(runes[start:end]) + suffix, shifted
+}
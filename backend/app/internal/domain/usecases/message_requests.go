@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+)
+
+var ErrTooManyMessageRequests = errors.New("too many message requests")
+
+/*
+NewMessageRequest returns the request a first conversation of from with to has to start as, or nil if to allowed from
+to message them. Senders can only send dailyLimit requests in 24 hours, however they were answered.
+*/
+func NewMessageRequest(ctx context.Context, allowedSenderRepo repository.AllowedSenderRepository, conversationRepo repository.ConversationRepository, from string, to string, dailyLimit int64) (*models.MessageRequest, error) {
+	allowed, err := allowedSenderRepo.IsAllowed(ctx, to, from)
+	if err != nil {
+		return nil, err
+	}
+	if allowed {
+		return nil, nil
+	}
+
+	now := time.Now()
+	sent, err := conversationRepo.CountMessageRequestsSince(ctx, from, now.Add(-24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if sent >= dailyLimit {
+		return nil, ErrTooManyMessageRequests
+	}
+
+	return &models.MessageRequest{From: from, To: to, Status: models.MessageRequestPending, CreatedAt: now}, nil
+}
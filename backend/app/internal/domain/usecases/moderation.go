@@ -0,0 +1,251 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+)
+
+// ErrInvalidModerationRule is reported by RefreshRules for the rules skipped because they fail to compile.
+var ErrInvalidModerationRule = errors.New("invalid moderation rule")
+
+// contactPatterns detect the ways of getting in touch off the platform, for the rules of the matching kinds.
+var contactPatterns = map[models.ModerationRuleKind]*regexp.Regexp{
+	models.ModerationRuleLink:  regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+|\b[a-z0-9][a-z0-9-]*\.(?:com|net|org|io|me|co|app|ly|gg)\b(?:/\S*)?`),
+	models.ModerationRuleEmail: regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}\b`),
+	models.ModerationRulePhone: regexp.MustCompile(`\+?\d[\d ().-]{7,}\d`),
+}
+
+// ModerationContent is a message or bio to moderate.
+type ModerationContent struct {
+	Subject models.ModerationSubject
+	Text    string
+	At      time.Time // When the message was sent or edited, or the bio edited.
+	Sent    bool      // Whether it is a newly sent message, the only content rate-based rules count.
+}
+
+// CompiledModerationRule is a rule ready to be checked, along with the pattern it matches for text-based kinds.
+type CompiledModerationRule struct {
+	models.ModerationRule
+	Matcher *regexp.Regexp // Nil for rate-based kinds.
+}
+
+// ModerationCheck is a step of the moderation pipeline, finding which of the rules the content breaks.
+type ModerationCheck interface {
+	Check(ctx context.Context, content ModerationContent, rules []CompiledModerationRule) ([]models.ModerationFinding, error)
+}
+
+/*
+ModerationPipeline runs content through its checks with the enabled rules applying to it. Rules are reloaded from the
+database once older than the refresh interval, so they can be changed without restarting.
+*/
+type ModerationPipeline struct {
+	moderationRepo  repository.ModerationRepository
+	checks          []ModerationCheck
+	refreshInterval time.Duration
+	rules           []CompiledModerationRule
+	loadedAt        time.Time
+}
+
+func NewModerationPipeline(moderationRepo repository.ModerationRepository, refreshInterval time.Duration, checks ...ModerationCheck) *ModerationPipeline {
+	return &ModerationPipeline{moderationRepo: moderationRepo, checks: checks, refreshInterval: refreshInterval}
+}
+
+/*
+RefreshRules reloads the rules if they are older than the refresh interval. Failing to load them keeps the previous
+ones and tries again on the next call, while rules that fail to compile are skipped and reported wrapping
+ErrInvalidModerationRule.
+*/
+func (p *ModerationPipeline) RefreshRules(ctx context.Context) error {
+	if !p.loadedAt.IsZero() && time.Since(p.loadedAt) < p.refreshInterval {
+		return nil
+	}
+	rules, err := p.moderationRepo.ListEnabledRules(ctx)
+	if err != nil {
+		return err
+	}
+	p.loadedAt = time.Now()
+
+	compiled := []CompiledModerationRule{}
+	var errs []error
+	for _, rule := range rules {
+		matcher, err := compileModerationRule(rule)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w %s: %w", ErrInvalidModerationRule, rule.Id.Hex(), err))
+			continue
+		}
+		compiled = append(compiled, CompiledModerationRule{ModerationRule: rule, Matcher: matcher})
+	}
+	p.rules = compiled
+
+	return errors.Join(errs...)
+}
+
+// Moderate finds the rules the content breaks, with the rules last loaded by RefreshRules.
+func (p *ModerationPipeline) Moderate(ctx context.Context, content ModerationContent) ([]models.ModerationFinding, error) {
+	rules := []CompiledModerationRule{}
+	for _, rule := range p.rules {
+		if rule.AppliesTo(content.Subject.Kind) {
+			rules = append(rules, rule)
+		}
+	}
+
+	findings := []models.ModerationFinding{}
+	for _, check := range p.checks {
+		found, err := check.Check(ctx, content, rules)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, found...)
+	}
+	return findings, nil
+}
+
+func compileModerationRule(rule models.ModerationRule) (*regexp.Regexp, error) {
+	if rule.Action.Severity() < 0 {
+		return nil, fmt.Errorf("unknown action %q", rule.Action)
+	}
+	switch rule.Kind {
+	case models.ModerationRuleWord:
+		if len(strings.TrimSpace(rule.Pattern)) == 0 {
+			return nil, errors.New("empty word")
+		}
+		// \b only knows ASCII, so words are delimited by anything but letters and digits instead
+		return regexp.Compile(`(?i)(?:^|[^\pL\pN])(` + regexp.QuoteMeta(strings.TrimSpace(rule.Pattern)) + `)(?:$|[^\pL\pN])`)
+	case models.ModerationRuleRegex:
+		return regexp.Compile(rule.Pattern)
+	case models.ModerationRuleLink, models.ModerationRuleEmail, models.ModerationRulePhone:
+		return contactPatterns[rule.Kind], nil
+	case models.ModerationRuleRate, models.ModerationRuleDuplicate:
+		if rule.Limit < 1 || rule.Window < 1 {
+			return nil, errors.New("limit and window must be positive")
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown kind %q", rule.Kind)
+	}
+}
+
+// PatternCheck checks the text-based rules: words, regular expressions and contact details.
+type PatternCheck struct{}
+
+func (PatternCheck) Check(ctx context.Context, content ModerationContent, rules []CompiledModerationRule) ([]models.ModerationFinding, error) {
+	findings := []models.ModerationFinding{}
+	for _, rule := range rules {
+		if rule.Matcher == nil {
+			continue
+		}
+		match := rule.Matcher.FindStringSubmatch(content.Text)
+		if match == nil {
+			continue
+		}
+		// words are matched along with their delimiters, captured apart
+		matched := match[0]
+		if rule.Kind == models.ModerationRuleWord {
+			matched = match[1]
+		}
+		findings = append(findings, models.ModerationFinding{
+			RuleId: rule.Id,
+			Kind:   rule.Kind,
+			Action: rule.Action,
+			Match:  matched,
+		})
+	}
+	return findings, nil
+}
+
+// SpamCheck checks the rate-based rules against the messages the sender sent recently.
+type SpamCheck struct {
+	ConversationRepo repository.ConversationRepository
+}
+
+func (c SpamCheck) Check(ctx context.Context, content ModerationContent, rules []CompiledModerationRule) ([]models.ModerationFinding, error) {
+	findings := []models.ModerationFinding{}
+	if !content.Sent {
+		return findings, nil
+	}
+
+	for _, rule := range rules {
+		window := time.Duration(rule.Window) * time.Second
+		since := content.At.Add(-window)
+
+		var count int64
+		var err error
+		switch rule.Kind {
+		case models.ModerationRuleRate:
+			count, err = c.ConversationRepo.CountMessagesSince(ctx, content.Subject.Username, since)
+		case models.ModerationRuleDuplicate:
+			count, err = c.ConversationRepo.CountConversationsWithText(ctx, content.Subject.Username, content.Text, since)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if count > rule.Limit {
+			findings = append(findings, models.ModerationFinding{
+				RuleId: rule.Id,
+				Kind:   rule.Kind,
+				Action: rule.Action,
+				Match:  fmt.Sprintf("%d in %s", count, window),
+			})
+		}
+	}
+	return findings, nil
+}
+
+/*
+ApplyModeration records the findings for moderators and takes the strongest of their actions: hiding the message or
+bio, and shadow restricting its author along. Nothing is recorded without findings. Applying it again to the same
+version of the content only takes the actions again, so it can be retried after failing.
+*/
+func ApplyModeration(ctx context.Context, userRepo repository.UserRepository, conversationRepo repository.ConversationRepository, moderationRepo repository.ModerationRepository, content ModerationContent, findings []models.ModerationFinding) (*models.ModerationFlag, error) {
+	if len(findings) == 0 {
+		return nil, nil
+	}
+
+	action := models.ModerationActionFlag
+	for _, finding := range findings {
+		if finding.Action.Severity() > action.Severity() {
+			action = finding.Action
+		}
+	}
+
+	flag, err := moderationRepo.CreateFlag(ctx, models.ModerationFlag{
+		Subject:  content.Subject,
+		At:       content.At,
+		Text:     content.Text,
+		Findings: findings,
+		Action:   action,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if action == models.ModerationActionFlag {
+		return flag, nil
+	}
+	if content.Subject.Kind == models.ModerationSubjectMessage {
+		err = conversationRepo.RestrictMessage(ctx, models.Message{Id: *content.Subject.MessageId, ConversationId: *content.Subject.ConversationId})
+	} else if action == models.ModerationActionHide {
+		err = userRepo.SetBioHidden(ctx, content.Subject.Username, true)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// shadow restricting hides the bio too
+	if action == models.ModerationActionShadowRestrict {
+		if err := userRepo.ShadowRestrict(ctx, content.Subject.Username); err != nil {
+			return nil, err
+		}
+	}
+
+	return flag, nil
+}
@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"skilly/internal/domain/models"
+)
+
+/*
+CanAccess reports whether the viewer may see the part of the owner's profile guarded by the given audience.
+Owners can always see their own profile; an unset audience is treated as everyone.
+*/
+func CanAccess(owner models.User, viewer string, audience models.Audience) bool {
+	if owner.Username == viewer {
+		return true
+	}
+
+	switch audience {
+	case models.AudienceContacts:
+		return owner.HasContact(viewer)
+	case models.AudienceNobody:
+		return false
+	default:
+		return true
+	}
+}
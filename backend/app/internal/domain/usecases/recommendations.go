@@ -0,0 +1,298 @@
+package usecases
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"skilly/internal/domain/models"
+)
+
+// Weights of the parts of the recommendation score, adding up to 1.
+const (
+	recommendationSkillWeight = 0.6
+	recommendationTextWeight  = 0.4
+)
+
+const (
+	recommendationMinScore       = 0.05 // Anything below is noise, e.g. a single shared common word.
+	recommendationMinAffinity    = 0.2  // Share of the learners of a skill that must also list another one for them to be related.
+	recommendationMinTermLength  = 3
+	recommendationMaxReasonTerms = 3
+)
+
+/*
+RecommendationModel suggests users to each other from a snapshot of every user, without any external service:
+  - skills are related by how often they are listed together, so users teaching skills related to the ones someone
+    learns are suggested along with the ones teaching them directly;
+  - bios and skill lists are compared by the cosine similarity of their TF-IDF vectors.
+
+Only the parts of profiles visible to everyone are taken into account, so recommendations never give hidden ones away.
+The model is not safe for concurrent use.
+*/
+type RecommendationModel struct {
+	users map[string]models.User
+
+	skillUsers    map[string]int            // Users listing the skill.
+	cooccurrences map[string]map[string]int // Users listing both skills.
+
+	documents         map[string]map[string]int // Term frequencies of the profile of each user.
+	documentFrequency map[string]int            // Users whose profile contains the term.
+	vectors           map[string]map[string]float64
+}
+
+func NewRecommendationModel(users []models.User) *RecommendationModel {
+	m := &RecommendationModel{
+		users:             map[string]models.User{},
+		skillUsers:        map[string]int{},
+		cooccurrences:     map[string]map[string]int{},
+		documents:         map[string]map[string]int{},
+		documentFrequency: map[string]int{},
+	}
+	for _, user := range users {
+		m.Update(user)
+	}
+	return m
+}
+
+// Usernames returns the users known to the model.
+func (m *RecommendationModel) Usernames() []string {
+	usernames := make([]string, 0, len(m.users))
+	for username := range m.users {
+		usernames = append(usernames, username)
+	}
+	slices.Sort(usernames)
+	return usernames
+}
+
+// Update adds the user to the model, or replaces what it knew about them.
+func (m *RecommendationModel) Update(user models.User) {
+	if previous, ok := m.users[user.Username]; ok {
+		m.count(previous, -1)
+	}
+	m.users[user.Username] = user
+	m.count(user, 1)
+	// document frequencies changed, so every vector has to be recomputed
+	m.vectors = nil
+}
+
+func (m *RecommendationModel) count(user models.User, delta int) {
+	skills := publicSkills(user)
+	for _, skill := range skills {
+		m.skillUsers[skill] += delta
+		for _, other := range skills {
+			if other == skill {
+				continue
+			}
+			if m.cooccurrences[skill] == nil {
+				m.cooccurrences[skill] = map[string]int{}
+			}
+			m.cooccurrences[skill][other] += delta
+		}
+	}
+
+	if delta < 0 {
+		for term := range m.documents[user.Username] {
+			m.documentFrequency[term]--
+			if m.documentFrequency[term] <= 0 {
+				delete(m.documentFrequency, term)
+			}
+		}
+		delete(m.documents, user.Username)
+		return
+	}
+
+	document := map[string]int{}
+	for _, term := range profileTerms(user) {
+		document[term]++
+	}
+	for term := range document {
+		m.documentFrequency[term]++
+	}
+	m.documents[user.Username] = document
+}
+
+// Recommend returns the best users to suggest to the user, best first.
+func (m *RecommendationModel) Recommend(username string, limit int) []models.Recommendation {
+	recommendations := []models.Recommendation{}
+	for other := range m.users {
+		if recommendation, ok := m.Score(username, other); ok {
+			recommendations = append(recommendations, recommendation)
+		}
+	}
+
+	sort.Slice(recommendations, func(i, j int) bool {
+		if recommendations[i].Score != recommendations[j].Score {
+			return recommendations[i].Score > recommendations[j].Score
+		}
+		return recommendations[i].Username < recommendations[j].Username
+	})
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+	return recommendations
+}
+
+// Score tells how good a suggestion the user is for the owner, if they should be suggested at all.
+func (m *RecommendationModel) Score(owner string, username string) (models.Recommendation, bool) {
+	target, ok := m.users[owner]
+	if !ok || owner == username {
+		return models.Recommendation{}, false
+	}
+	candidate, ok := m.users[username]
+	if !ok || candidate.Privacy.HiddenFromSearch || !CanAccess(candidate, owner, candidate.Privacy.ProfileVisibility) {
+		return models.Recommendation{}, false
+	}
+
+	recommendation := models.Recommendation{Username: username, Reasons: []models.RecommendationReason{}}
+
+	skillScore := 0.0
+	direct := []string{}
+	related := map[string]float64{}
+	for _, skill := range candidate.Teaching {
+		if slices.Contains(target.Learning, skill) {
+			direct = append(direct, skill)
+			skillScore += 1
+		} else if affinity := m.affinity(target.Learning, skill); affinity >= recommendationMinAffinity {
+			related[skill] = affinity
+			skillScore += affinity
+		}
+	}
+	if len(target.Learning) > 0 {
+		skillScore = min(1, skillScore/float64(len(target.Learning)))
+	}
+
+	if len(direct) > 0 {
+		slices.Sort(direct)
+		recommendation.Reasons = append(recommendation.Reasons, models.RecommendationReason{
+			Kind:  models.RecommendationReasonTeachesYourSkills,
+			Terms: direct,
+		})
+	}
+	if len(related) > 0 {
+		recommendation.Reasons = append(recommendation.Reasons, models.RecommendationReason{
+			Kind:  models.RecommendationReasonTeachesRelatedSkills,
+			Terms: topTerms(related),
+		})
+	}
+
+	textScore, shared := m.similarity(owner, username)
+	if len(shared) > 0 {
+		recommendation.Reasons = append(recommendation.Reasons, models.RecommendationReason{
+			Kind:  models.RecommendationReasonSimilarProfile,
+			Terms: topTerms(shared),
+		})
+	}
+
+	recommendation.Score = recommendationSkillWeight*skillScore + recommendationTextWeight*textScore
+	if recommendation.Score < recommendationMinScore {
+		return models.Recommendation{}, false
+	}
+	return recommendation, true
+}
+
+// affinity is the largest share of the learners of one of the skills who also list the other skill.
+func (m *RecommendationModel) affinity(learning []string, skill string) float64 {
+	affinity := 0.0
+	for _, learned := range learning {
+		if m.skillUsers[learned] > 0 {
+			affinity = max(affinity, float64(m.cooccurrences[learned][skill])/float64(m.skillUsers[learned]))
+		}
+	}
+	return affinity
+}
+
+// similarity is the cosine similarity of the profiles of both users, along with how much each shared term adds to it.
+func (m *RecommendationModel) similarity(a string, b string) (float64, map[string]float64) {
+	if m.vectors == nil {
+		m.vectors = map[string]map[string]float64{}
+	}
+	vectorA, vectorB := m.vector(a), m.vector(b)
+
+	similarity := 0.0
+	shared := map[string]float64{}
+	for term, weight := range vectorA {
+		if other, ok := vectorB[term]; ok {
+			shared[term] = weight * other
+			similarity += weight * other
+		}
+	}
+	return similarity, shared
+}
+
+// vector returns the L2 normalized TF-IDF vector of the user's profile.
+func (m *RecommendationModel) vector(username string) map[string]float64 {
+	if vector, ok := m.vectors[username]; ok {
+		return vector
+	}
+
+	vector := map[string]float64{}
+	norm := 0.0
+	for term, frequency := range m.documents[username] {
+		idf := math.Log(float64(1+len(m.documents))/float64(1+m.documentFrequency[term])) + 1
+		weight := (1 + math.Log(float64(frequency))) * idf
+		vector[term] = weight
+		norm += weight * weight
+	}
+	norm = math.Sqrt(norm)
+	for term := range vector {
+		vector[term] /= norm
+	}
+
+	m.vectors[username] = vector
+	return vector
+}
+
+// topTerms returns the terms with the largest values, largest first.
+func topTerms(values map[string]float64) []string {
+	terms := make([]string, 0, len(values))
+	for term := range values {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if values[terms[i]] != values[terms[j]] {
+			return values[terms[i]] > values[terms[j]]
+		}
+		return terms[i] < terms[j]
+	})
+	if len(terms) > recommendationMaxReasonTerms {
+		terms = terms[:recommendationMaxReasonTerms]
+	}
+	return terms
+}
+
+// publicSkills returns the distinct skills the user lists publicly.
+func publicSkills(user models.User) []string {
+	skills := slices.Clone(user.Teaching)
+	if !user.Privacy.HideLearning {
+		skills = append(skills, user.Learning...)
+	}
+	slices.Sort(skills)
+	return slices.Compact(skills)
+}
+
+// profileTerms splits the public parts of the user's profile into lowercase words.
+func profileTerms(user models.User) []string {
+	texts := slices.Clone(user.Teaching)
+	if !user.Privacy.HideLearning {
+		texts = append(texts, user.Learning...)
+	}
+	if !user.Privacy.HideBio && !user.BioHidden {
+		texts = append(texts, user.Bio)
+	}
+
+	terms := []string{}
+	for _, text := range texts {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			if len([]rune(word)) >= recommendationMinTermLength {
+				terms = append(terms, word)
+			}
+		}
+	}
+	return terms
+}
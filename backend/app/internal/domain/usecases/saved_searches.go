@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+)
+
+/*
+MatchSavedSearches checks the user against the saved searches of other users and records an alert for every search
+the user newly matches, as if its owner ran it right now. It returns the saved searches alerted.
+*/
+func MatchSavedSearches(ctx context.Context, userRepo repository.UserRepository, blockRepo repository.BlockRepository, savedSearchRepo repository.SavedSearchRepository, user models.User, at time.Time) ([]models.SavedSearch, error) {
+	candidates, err := savedSearchRepo.FindCandidateSavedSearches(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	alerted := []models.SavedSearch{}
+	for _, search := range candidates {
+		owner, err := userRepo.GetUserByUsername(ctx, search.Owner)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		blocked, err := blockRepo.GetBlockedUsernames(ctx, owner.Username)
+		if err != nil {
+			return nil, err
+		}
+
+		learning, teaching := repository.SkillFilters(repository.UserSearchMode(search.Mode), *owner, search.Skills)
+		count, err := userRepo.CountUsers(ctx, repository.UserSearchQuery{
+			ExcludeUsername:  owner.Username,
+			Username:         search.Username,
+			UsernameInfix:    search.UsernameInfix,
+			Learning:         learning,
+			Teaching:         teaching,
+			Languages:        search.Languages,
+			Text:             search.Query,
+			TextStems:        HighlightTerms(search.Query),
+			ExcludeUsernames: blocked,
+			OnlyUsername:     user.Username,
+		}, 1)
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			continue
+		}
+
+		created, err := savedSearchRepo.RecordAlert(ctx, models.SearchAlert{
+			Owner:           owner.Username,
+			SavedSearchId:   search.Id,
+			SavedSearchName: search.Name,
+			Username:        user.Username,
+			CreatedAt:       at,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			alerted = append(alerted, search)
+		}
+	}
+
+	return alerted, nil
+}
@@ -41,6 +41,10 @@ func MustNewDependencies() *Dependencies {
 }
 
 func (d *Dependencies) Close(ctx context.Context) {
+	d.Logger.Info("Disconnecting Kafka client...")
+	if err := d.Kafka.Disconnect(); err != nil {
+		d.Logger.Error("Failed to disconnect Kafka client", slog.Any("error", err))
+	}
 	d.Logger.Info("Disconnecting MongoDB client...")
 	d.Mongo.Disconnect(ctx)
 }
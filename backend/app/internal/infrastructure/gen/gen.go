@@ -12,18 +12,340 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for AttachmentStatus.
+const (
+	AttachmentStatusPending  AttachmentStatus = "pending"
+	AttachmentStatusReady    AttachmentStatus = "ready"
+	AttachmentStatusRejected AttachmentStatus = "rejected"
+)
+
+// Defines values for Audience.
+const (
+	AudienceEveryone Audience = "everyone"
+	AudienceContacts Audience = "contacts"
+	AudienceNobody   Audience = "nobody"
+)
+
+// Defines values for ConversationKind.
+const (
+	ConversationKindDirect ConversationKind = "direct"
+	ConversationKindGroup  ConversationKind = "group"
+)
+
+// Defines values for DeleteScope.
+const (
+	DeleteScopeEveryone DeleteScope = "everyone"
+	DeleteScopeMe       DeleteScope = "me"
+)
+
+// Defines values for GroupRole.
+const (
+	GroupRoleOwner  GroupRole = "owner"
+	GroupRoleAdmin  GroupRole = "admin"
+	GroupRoleMember GroupRole = "member"
+)
+
+// Defines values for HighlightField.
+const (
+	HighlightFieldBio      HighlightField = "bio"
+	HighlightFieldTeaching HighlightField = "teaching"
+	HighlightFieldLearning HighlightField = "learning"
+)
+
+// Defines values for MessageRequestAction.
+const (
+	MessageRequestActionAccept  MessageRequestAction = "accept"
+	MessageRequestActionDecline MessageRequestAction = "decline"
+	MessageRequestActionBlock   MessageRequestAction = "block"
+)
+
+// Defines values for MessageRequestStatus.
+const (
+	MessageRequestStatusPending  MessageRequestStatus = "pending"
+	MessageRequestStatusAccepted MessageRequestStatus = "accepted"
+	MessageRequestStatusDeclined MessageRequestStatus = "declined"
+)
+
+// Defines values for MessageRevisionKind.
+const (
+	MessageRevisionKindEdit   MessageRevisionKind = "edit"
+	MessageRevisionKindDelete MessageRevisionKind = "delete"
+)
+
+// Defines values for ModerationAction.
+const (
+	ModerationActionFlag           ModerationAction = "flag"
+	ModerationActionHide           ModerationAction = "hide"
+	ModerationActionShadowRestrict ModerationAction = "shadow_restrict"
+)
+
+// Defines values for ModerationRuleKind.
+const (
+	ModerationRuleKindWord      ModerationRuleKind = "word"
+	ModerationRuleKindRegex     ModerationRuleKind = "regex"
+	ModerationRuleKindLink      ModerationRuleKind = "link"
+	ModerationRuleKindEmail     ModerationRuleKind = "email"
+	ModerationRuleKindPhone     ModerationRuleKind = "phone"
+	ModerationRuleKindRate      ModerationRuleKind = "rate"
+	ModerationRuleKindDuplicate ModerationRuleKind = "duplicate"
+)
+
+// Defines values for ModerationSubjectKind.
+const (
+	ModerationSubjectKindMessage ModerationSubjectKind = "message"
+	ModerationSubjectKindBio     ModerationSubjectKind = "bio"
+)
+
+// Defines values for NotificationKind.
+const (
+	NotificationKindSearchAlert NotificationKind = "search_alert"
+)
+
+// Defines values for RealtimeEventType.
+const (
+	RealtimeEventTypeMessage        RealtimeEventType = "message"
+	RealtimeEventTypeNotification   RealtimeEventType = "notification"
+	RealtimeEventTypePresence       RealtimeEventType = "presence"
+	RealtimeEventTypeRead           RealtimeEventType = "read"
+	RealtimeEventTypeTyping         RealtimeEventType = "typing"
+	RealtimeEventTypeMessageEdited  RealtimeEventType = "message_edited"
+	RealtimeEventTypeMessageDeleted RealtimeEventType = "message_deleted"
+	RealtimeEventTypeMessageRequest RealtimeEventType = "message_request"
+)
+
+// Defines values for RecommendationReasonKind.
+const (
+	RecommendationReasonKindTeachesYourSkills    RecommendationReasonKind = "teaches_your_skills"
+	RecommendationReasonKindTeachesRelatedSkills RecommendationReasonKind = "teaches_related_skills"
+	RecommendationReasonKindSimilarProfile       RecommendationReasonKind = "similar_profile"
+)
+
+// Defines values for SearchMode.
+const (
+	SearchModeExchange SearchMode = "exchange"
+	SearchModeTeachers SearchMode = "teachers"
+	SearchModeLearners SearchMode = "learners"
+)
+
+// Defines values for SearchSort.
+const (
+	SearchSortDefault        SearchSort = "default"
+	SearchSortNewest         SearchSort = "newest"
+	SearchSortRecentlyActive SearchSort = "recently_active"
+	SearchSortEndorsements   SearchSort = "endorsements"
+	SearchSortAlphabetical   SearchSort = "alphabetical"
+)
+
+// AllowedSender defines model for AllowedSender.
+type AllowedSender struct {
+	// AllowedAt When the user was allowed.
+	AllowedAt time.Time `json:"allowed_at"`
+
+	// Username Username of the user allowed to message the current user without a request.
+	Username string `json:"username"`
+}
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	// ContentType Type of the file as detected from its content, once checked. Images, PDF, plain text, XML and zip based documents are supported.
+	ContentType *string `json:"content_type,omitempty"`
+
+	// ConversationId Identifier of the conversation the attachment was uploaded to.
+	ConversationId string `json:"conversation_id"`
+
+	// CreatedAt When the attachment was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Filename Name of the file.
+	Filename string `json:"filename"`
+
+	// HasPreview Whether a smaller JPEG preview of the image is available.
+	HasPreview bool `json:"has_preview"`
+
+	// Id Identifier of the attachment.
+	Id string `json:"id"`
+
+	// Size Size of the file in bytes, once checked.
+	Size   *int             `json:"size,omitempty"`
+	Status AttachmentStatus `json:"status"`
+
+	// Uploader Username of the participant who uploaded the attachment.
+	Uploader string `json:"uploader"`
+}
+
+// AttachmentResponse defines model for AttachmentResponse.
+type AttachmentResponse struct {
+	Attachment Attachment `json:"attachment"`
+
+	// PreviewUrl Presigned URL to download the preview, valid for 15 minutes, if there is one.
+	PreviewUrl *string `json:"preview_url,omitempty"`
+
+	// Url Presigned URL to download the file, valid for 15 minutes, once ready.
+	Url *string `json:"url,omitempty"`
+}
+
+// AttachmentStatus defines model for AttachmentStatus.
+type AttachmentStatus string
+
+// Audience defines model for Audience.
+type Audience string
+
+// BlockedUser defines model for BlockedUser.
+type BlockedUser struct {
+	// BlockedAt When the user was blocked.
+	BlockedAt time.Time `json:"blocked_at"`
+
+	// Username Username of the blocked user.
+	Username string `json:"username"`
+}
+
 // CheckUsernameResponse defines model for CheckUsernameResponse.
 type CheckUsernameResponse struct {
 	// Available Whether the username is available or not.
 	Available bool `json:"available"`
 }
 
+// Circle defines model for Circle.
+type Circle struct {
+	// Description What the circle is for.
+	Description string `json:"description"`
+
+	// Id Identifier of the group.
+	Id string `json:"id"`
+
+	// Joined Whether the current user is a member.
+	Joined bool `json:"joined"`
+
+	// MemberCount Number of members.
+	MemberCount int `json:"member_count"`
+
+	// MemberLimit Maximum number of members.
+	MemberLimit int `json:"member_limit"`
+
+	// Name Name of the circle.
+	Name string `json:"name"`
+
+	// Skill Skill the circle is about.
+	Skill string `json:"skill"`
+}
+
+// CirclesResponse defines model for CirclesResponse.
+type CirclesResponse struct {
+	// Circles Circles, most recently created first.
+	Circles []Circle `json:"circles"`
+
+	// NextCursor Cursor to get the next page with. Only set if there are more circles.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Conversation defines model for Conversation.
+type Conversation struct {
+	// CreatedAt When the conversation was started.
+	CreatedAt time.Time `json:"created_at"`
+	Group     *Group    `json:"group,omitempty"`
+
+	// Id Identifier of the conversation.
+	Id          string           `json:"id"`
+	Kind        ConversationKind `json:"kind"`
+	LastMessage *Message         `json:"last_message,omitempty"`
+
+	// Reads Last message read by each participant, for those who have read any.
+	Reads   []ReadReceipt   `json:"reads"`
+	Request *MessageRequest `json:"request,omitempty"`
+
+	// UnreadCount Number of messages the current user hasn't read yet.
+	UnreadCount int `json:"unread_count"`
+
+	// UpdatedAt When the last message was sent, or the conversation started if it has none.
+	UpdatedAt time.Time    `json:"updated_at"`
+	User      *UserProfile `json:"user,omitempty"`
+}
+
+// ConversationKind defines model for ConversationKind.
+type ConversationKind string
+
+// CreateAttachmentRequest defines model for CreateAttachmentRequest.
+type CreateAttachmentRequest struct {
+	// Filename Name of the file, as shown to the participants.
+	Filename string `json:"filename"`
+
+	// Size Size of the file in bytes, at most 10 MiB.
+	Size int `json:"size"`
+}
+
+// CreateAttachmentResponse defines model for CreateAttachmentResponse.
+type CreateAttachmentResponse struct {
+	Attachment Attachment `json:"attachment"`
+
+	// UploadUrl Presigned URL to PUT the file to, valid for 5 minutes. The file can only be uploaded once: uploading it again
+	// after it was checked rejects the attachment.
+	UploadUrl string `json:"upload_url"`
+}
+
+// CreateGroupRequest defines model for CreateGroupRequest.
+type CreateGroupRequest struct {
+	// Description What the group is for.
+	Description *string `json:"description,omitempty"`
+
+	// MemberLimit Maximum number of members, the owner included.
+	MemberLimit *int `json:"member_limit,omitempty"`
+
+	// Name Name of the group, e.g. "Spanish practice".
+	Name string `json:"name"`
+
+	// Public Whether the group is a circle, found by skill and joined by anyone, rather than only joined with an invite.
+	Public *bool `json:"public,omitempty"`
+
+	// Skill Skill the group is about.
+	Skill string `json:"skill"`
+}
+
+// CreateInviteRequest defines model for CreateInviteRequest.
+type CreateInviteRequest struct {
+	// ExpiresInHours How long the invite can be used for, in hours.
+	ExpiresInHours *int `json:"expires_in_hours,omitempty"`
+}
+
+// DeleteScope defines model for DeleteScope.
+type DeleteScope string
+
+// EditMessageRequest defines model for EditMessageRequest.
+type EditMessageRequest struct {
+	// Text New text of the message, which may only be empty if it has attachments.
+	Text string `json:"text"`
+}
+
+// EndorseRequest defines model for EndorseRequest.
+type EndorseRequest struct {
+	// Comment Optional comment shown with the endorsement.
+	Comment *string `json:"comment,omitempty"`
+
+	// Skill Teaching skill of the user to endorse.
+	Skill string `json:"skill"`
+}
+
+// Endorsement defines model for Endorsement.
+type Endorsement struct {
+	// Comment Comment left by the endorsing user.
+	Comment string `json:"comment"`
+
+	// CreatedAt When the skill was endorsed.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Endorser Username of the endorsing user.
+	Endorser string `json:"endorser"`
+
+	// Skill Endorsed skill.
+	Skill string `json:"skill"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code An application-specific error code.
@@ -36,6 +358,112 @@ type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// FacetCount defines model for FacetCount.
+type FacetCount struct {
+	// Count Number of matching users with the value.
+	Count int64 `json:"count"`
+
+	// Value Skill or language.
+	Value string `json:"value"`
+}
+
+// Favourite defines model for Favourite.
+type Favourite struct {
+	// CreatedAt When the user was added to favourites.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Note Private note about the user.
+	Note string `json:"note"`
+
+	// Tags Private tags of the favourite.
+	Tags []string    `json:"tags"`
+	User UserProfile `json:"user"`
+}
+
+// FavouriteRequest defines model for FavouriteRequest.
+type FavouriteRequest struct {
+	// Note Private note about the user, only visible to the current user.
+	Note *string `json:"note,omitempty"`
+
+	// Tags Private tags to organise favourites with.
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// Group defines model for Group.
+type Group struct {
+	// Description What the group is for.
+	Description string `json:"description"`
+
+	// MemberLimit Maximum number of members.
+	MemberLimit int `json:"member_limit"`
+
+	// Members Members of the group, in the order they joined.
+	Members []GroupMember `json:"members"`
+
+	// Name Name of the group.
+	Name string `json:"name"`
+
+	// Public Whether the group is a circle, found by skill and joined by anyone.
+	Public bool `json:"public"`
+
+	// Skill Skill the group is about.
+	Skill string `json:"skill"`
+}
+
+// GroupInvite defines model for GroupInvite.
+type GroupInvite struct {
+	// Code Code to join the group with, to be shared as part of a link.
+	Code string `json:"code"`
+
+	// ExpiresAt When the invite stops working.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// GroupId Identifier of the group.
+	GroupId string `json:"group_id"`
+}
+
+// GroupMember defines model for GroupMember.
+type GroupMember struct {
+	// JoinedAt When the member joined the group.
+	JoinedAt time.Time `json:"joined_at"`
+	Role     GroupRole `json:"role"`
+
+	// Username Username of the member.
+	Username string `json:"username"`
+}
+
+// GroupRole defines model for GroupRole.
+type GroupRole string
+
+// Highlight defines model for Highlight.
+type Highlight struct {
+	Field HighlightField `json:"field"`
+
+	// Ranges Parts of the text matching the query.
+	Ranges []HighlightRange `json:"ranges"`
+
+	// Text Snippet of the field, or the whole skill for skill fields.
+	Text string `json:"text"`
+}
+
+// HighlightField defines model for HighlightField.
+type HighlightField string
+
+// HighlightRange defines model for HighlightRange.
+type HighlightRange struct {
+	// End Offset after the last matching character in the text, in characters.
+	End int32 `json:"end"`
+
+	// Start Offset of the first matching character in the text, in characters.
+	Start int32 `json:"start"`
+}
+
+// JoinGroupRequest defines model for JoinGroupRequest.
+type JoinGroupRequest struct {
+	// Code Code of an invite to the group.
+	Code string `json:"code"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	// Password Password to login.
@@ -45,26 +473,314 @@ type LoginRequest struct {
 	Username string `json:"username"`
 }
 
+// MarkReadRequest defines model for MarkReadRequest.
+type MarkReadRequest struct {
+	// MessageId Identifier of the last message read, marking the ones before it as read too.
+	MessageId string `json:"message_id"`
+}
+
+// Match defines model for Match.
+type Match struct {
+	// LearnsFromMe Skills the current user teaches that the matched user wants to learn.
+	LearnsFromMe []string `json:"learns_from_me"`
+
+	// Score How good the match is, from 0 to 1.
+	Score float64 `json:"score"`
+
+	// TeachesMe Skills the current user wants to learn that the matched user teaches.
+	TeachesMe []string    `json:"teaches_me"`
+	User      UserProfile `json:"user"`
+}
+
+// Message defines model for Message.
+type Message struct {
+	Attachments *[]Attachment `json:"attachments,omitempty"`
+
+	// ConversationId Identifier of the conversation of the message.
+	ConversationId string `json:"conversation_id"`
+
+	// CreatedAt When the message was sent.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Deleted Whether the sender deleted the message for everyone, leaving this tombstone without text or attachments.
+	Deleted bool `json:"deleted"`
+
+	// EditedAt When the message was last edited, if it was.
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+
+	// Id Identifier of the message.
+	Id string `json:"id"`
+
+	// Sender Username of the sender.
+	Sender string `json:"sender"`
+
+	// Text Text of the message.
+	Text string `json:"text"`
+}
+
+// MessageHighlight defines model for MessageHighlight.
+type MessageHighlight struct {
+	// Ranges Parts of the text matching the query.
+	Ranges []HighlightRange `json:"ranges"`
+
+	// Text Snippet of the text of the message.
+	Text string `json:"text"`
+}
+
+// MessageHistory defines model for MessageHistory.
+type MessageHistory struct {
+	Message Message `json:"message"`
+
+	// Revisions Previous versions of the message, oldest first.
+	Revisions []MessageRevision `json:"revisions"`
+}
+
+// MessageRequest defines model for MessageRequest.
+type MessageRequest struct {
+	// CreatedAt When the request was sent.
+	CreatedAt time.Time `json:"created_at"`
+
+	// From Username of the user who started the conversation.
+	From   string               `json:"from"`
+	Status MessageRequestStatus `json:"status"`
+}
+
+// MessageRequestAction defines model for MessageRequestAction.
+type MessageRequestAction string
+
+// MessageRequestStatus defines model for MessageRequestStatus.
+type MessageRequestStatus string
+
+// MessageRevision defines model for MessageRevision.
+type MessageRevision struct {
+	// Attachments Attachments of the message before it was revised.
+	Attachments *[]Attachment       `json:"attachments,omitempty"`
+	Kind        MessageRevisionKind `json:"kind"`
+
+	// RevisedAt When the message was revised.
+	RevisedAt time.Time `json:"revised_at"`
+
+	// Text Text of the message before it was revised.
+	Text string `json:"text"`
+}
+
+// MessageRevisionKind defines model for MessageRevisionKind.
+type MessageRevisionKind string
+
+// MessageSearchResult defines model for MessageSearchResult.
+type MessageSearchResult struct {
+	Highlight MessageHighlight `json:"highlight"`
+	Message   Message          `json:"message"`
+}
+
+// ModerationAction defines model for ModerationAction.
+type ModerationAction string
+
+// ModerationFinding defines model for ModerationFinding.
+type ModerationFinding struct {
+	Action ModerationAction   `json:"action"`
+	Kind   ModerationRuleKind `json:"kind"`
+
+	// Match Part of the text breaking the rule, or what rate-based rules counted.
+	Match string `json:"match"`
+
+	// RuleId Identifier of the rule broken.
+	RuleId string `json:"rule_id"`
+}
+
+// ModerationFlag defines model for ModerationFlag.
+type ModerationFlag struct {
+	Action ModerationAction `json:"action"`
+
+	// ConversationId Identifier of the conversation of the message. Only set for messages.
+	ConversationId *string `json:"conversation_id,omitempty"`
+
+	// CreatedAt When the content was flagged.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Findings Rules broken.
+	Findings []ModerationFinding `json:"findings"`
+
+	// Id Identifier of the flag.
+	Id   string                `json:"id"`
+	Kind ModerationSubjectKind `json:"kind"`
+
+	// MessageId Identifier of the message. Only set for messages.
+	MessageId *string `json:"message_id,omitempty"`
+
+	// Text Text of the message or bio as it was when moderated.
+	Text string `json:"text"`
+
+	// Username Author of the message or bio.
+	Username string `json:"username"`
+}
+
+// ModerationRuleKind defines model for ModerationRuleKind.
+type ModerationRuleKind string
+
+// ModerationSubjectKind defines model for ModerationSubjectKind.
+type ModerationSubjectKind string
+
+// Notification defines model for Notification.
+type Notification struct {
+	// CreatedAt When the notification was sent.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Data Details of the notification, depending on its kind.
+	Data map[string]string `json:"data"`
+	Kind NotificationKind  `json:"kind"`
+}
+
+// NotificationKind defines model for NotificationKind.
+type NotificationKind string
+
+// Presence defines model for Presence.
+type Presence struct {
+	// Online Whether the user is connected to real-time events.
+	Online bool `json:"online"`
+
+	// Username Username of the user.
+	Username string `json:"username"`
+}
+
+// PrivacyEditRequest defines model for PrivacyEditRequest.
+type PrivacyEditRequest struct {
+	// HiddenFromSearch Whether the user is excluded from search results.
+	HiddenFromSearch *bool `json:"hidden_from_search,omitempty"`
+
+	// HideBio Whether the bio is hidden from other users.
+	HideBio *bool `json:"hide_bio,omitempty"`
+
+	// HideEndorsements Whether the endorsements of the user are hidden from other users.
+	HideEndorsements *bool `json:"hide_endorsements,omitempty"`
+
+	// HideLearning Whether the learning list is hidden from other users.
+	HideLearning *bool `json:"hide_learning,omitempty"`
+
+	// HideProfileViews Whether views of the user's profile are not collected and the user is left out of other users' recent viewers.
+	HideProfileViews  *bool     `json:"hide_profile_views,omitempty"`
+	PictureVisibility *Audience `json:"picture_visibility,omitempty"`
+	ProfileVisibility *Audience `json:"profile_visibility,omitempty"`
+}
+
+// PrivacySettings defines model for PrivacySettings.
+type PrivacySettings struct {
+	// HiddenFromSearch Whether the user is excluded from search results.
+	HiddenFromSearch bool `json:"hidden_from_search"`
+
+	// HideBio Whether the bio is hidden from other users.
+	HideBio bool `json:"hide_bio"`
+
+	// HideEndorsements Whether the endorsements of the user are hidden from other users.
+	HideEndorsements bool `json:"hide_endorsements"`
+
+	// HideLearning Whether the learning list is hidden from other users.
+	HideLearning bool `json:"hide_learning"`
+
+	// HideProfileViews Whether views of the user's profile are not collected and the user is left out of other users' recent viewers.
+	HideProfileViews  bool     `json:"hide_profile_views"`
+	PictureVisibility Audience `json:"picture_visibility"`
+	ProfileVisibility Audience `json:"profile_visibility"`
+}
+
 // ProfileEditRequest defines model for ProfileEditRequest.
 type ProfileEditRequest struct {
 	// Bio Short user biography.
 	Bio *string `json:"bio,omitempty"`
 
+	// Languages Languages the user speaks.
+	Languages *[]string `json:"languages,omitempty"`
+
 	// Learning Skills the user wants to learn.
 	Learning *[]string `json:"learning,omitempty"`
 
 	// Password New password for the user.
 	Password *string `json:"password,omitempty"`
 
+	// SkillLevels Proficiency in the teaching skills. Replaces all previously set levels.
+	SkillLevels *[]SkillLevel `json:"skill_levels,omitempty"`
+
 	// Teaching Skills the user is willing to teach.
 	Teaching *[]string `json:"teaching,omitempty"`
 }
 
+// ProfileViewer defines model for ProfileViewer.
+type ProfileViewer struct {
+	// Username Username of the viewer.
+	Username string `json:"username"`
+
+	// ViewedAt When the viewer last viewed the profile that day.
+	ViewedAt time.Time `json:"viewed_at"`
+}
+
+// ProfileViewsDay defines model for ProfileViewsDay.
+type ProfileViewsDay struct {
+	// Day UTC day in YYYY-MM-DD format.
+	Day string `json:"day"`
+
+	// UniqueViewers Number of distinct users who viewed the profile that day.
+	UniqueViewers int64 `json:"unique_viewers"`
+
+	// Views Number of times the profile was viewed that day.
+	Views int64 `json:"views"`
+}
+
+// ReadReceipt defines model for ReadReceipt.
+type ReadReceipt struct {
+	// ConversationId Identifier of the conversation.
+	ConversationId string `json:"conversation_id"`
+
+	// MessageId Identifier of the last message read.
+	MessageId string `json:"message_id"`
+
+	// ReadAt When the messages were marked as read.
+	ReadAt time.Time `json:"read_at"`
+
+	// Username Username of the participant who read the messages.
+	Username string `json:"username"`
+}
+
+// RealtimeEvent defines model for RealtimeEvent.
+type RealtimeEvent struct {
+	Message      *Message          `json:"message,omitempty"`
+	Notification *Notification     `json:"notification,omitempty"`
+	Presence     *Presence         `json:"presence,omitempty"`
+	Read         *ReadReceipt      `json:"read,omitempty"`
+	Type         RealtimeEventType `json:"type"`
+	Typing       *Typing           `json:"typing,omitempty"`
+}
+
+// RealtimeEventType defines model for RealtimeEventType.
+type RealtimeEventType string
+
+// Recommendation defines model for Recommendation.
+type Recommendation struct {
+	Reasons []RecommendationReason `json:"reasons"`
+
+	// Score How strongly the user is recommended, from 0 to 1.
+	Score float64     `json:"score"`
+	User  UserProfile `json:"user"`
+}
+
+// RecommendationReason defines model for RecommendationReason.
+type RecommendationReason struct {
+	Kind RecommendationReasonKind `json:"kind"`
+
+	// Terms Skills or words behind the reason, most significant first.
+	Terms []string `json:"terms"`
+}
+
+// RecommendationReasonKind defines model for RecommendationReasonKind.
+type RecommendationReasonKind string
+
 // RegisterRequest defines model for RegisterRequest.
 type RegisterRequest struct {
 	// Bio Bio to register.
 	Bio string `json:"bio"`
 
+	// Languages Languages the user speaks.
+	Languages *[]string `json:"languages,omitempty"`
+
 	// Learning Skills to learn.
 	Learning []string `json:"learning"`
 
@@ -78,59 +794,422 @@ type RegisterRequest struct {
 	Username string `json:"username"`
 }
 
-// SearchRequest defines model for SearchRequest.
-type SearchRequest struct {
-	// Page Page number to retrieve.
-	Page *int32 `json:"page,omitempty"`
+// RespondMessageRequestRequest defines model for RespondMessageRequestRequest.
+type RespondMessageRequestRequest struct {
+	Action MessageRequestAction `json:"action"`
+}
 
-	// Pagesize Number of items to retrieve per page.
-	Pagesize *int32 `json:"pagesize,omitempty"`
+// SavedSearch defines model for SavedSearch.
+type SavedSearch struct {
+	// CreatedAt When the search was saved.
+	CreatedAt time.Time `json:"created_at"`
 
-	// Skills Skills to search.
-	Skills *[]string `json:"skills,omitempty"`
+	// Id Identifier of the saved search.
+	Id string `json:"id"`
 
-	// Username Username to search.
-	Username *string `json:"username,omitempty"`
-}
+	// Languages Languages searched for.
+	Languages []string   `json:"languages"`
+	Mode      SearchMode `json:"mode"`
 
-// UserProfile defines model for UserProfile.
-type UserProfile struct {
-	// Bio Short user biography.
-	Bio string `json:"bio"`
+	// Name Name of the saved search.
+	Name string `json:"name"`
 
-	// Learning Skills the user wants to learn.
-	Learning []string `json:"learning"`
+	// Notify Whether the user is notified by email or push about new matches.
+	Notify bool `json:"notify"`
 
-	// Teaching Skills the user is willing to teach.
-	Teaching []string `json:"teaching"`
+	// Query Free text searched for.
+	Query string `json:"query"`
 
-	// Username Username of the user.
+	// Skills Skills searched for.
+	Skills []string `json:"skills"`
+
+	// Username Username searched for.
+	Username string `json:"username"`
+
+	// UsernameInfix Whether the username is searched anywhere in usernames rather than as a prefix.
+	UsernameInfix bool `json:"username_infix"`
+}
+
+// SavedSearchRequest defines model for SavedSearchRequest.
+type SavedSearchRequest struct {
+	// Name Name of the saved search.
+	Name string `json:"name"`
+
+	// Notify Whether to also notify the user by email or push when the saved search has new matches.
+	Notify *bool         `json:"notify,omitempty"`
+	Search SearchRequest `json:"search"`
+}
+
+// SearchAlert defines model for SearchAlert.
+type SearchAlert struct {
+	// CreatedAt When the user started matching the saved search.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Identifier of the alert.
+	Id string `json:"id"`
+
+	// Read Whether the alert has been marked as read.
+	Read bool `json:"read"`
+
+	// SavedSearchId Identifier of the saved search that matched.
+	SavedSearchId string `json:"saved_search_id"`
+
+	// SavedSearchName Name of the saved search that matched.
+	SavedSearchName string `json:"saved_search_name"`
+
+	// Username Username of the newly matching user.
+	Username string `json:"username"`
+}
+
+// SearchCirclesRequest defines model for SearchCirclesRequest.
+type SearchCirclesRequest struct {
+	// Cursor Cursor returned as `next_cursor` by the previous search with the same parameters.
+	Cursor *string     `json:"cursor,omitempty"`
+	Mode   *SearchMode `json:"mode,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *int32 `json:"pagesize,omitempty"`
+
+	// Skills Skills to search, the circles found being about one of them or the current user's skills depending on `mode`.
+	Skills *[]string `json:"skills,omitempty"`
+}
+
+// SearchFacets defines model for SearchFacets.
+type SearchFacets struct {
+	// Languages Most common languages among the matching users.
+	Languages []FacetCount `json:"languages"`
+
+	// Learning Most common learning skills among the matching users.
+	Learning []FacetCount `json:"learning"`
+
+	// Teaching Most common teaching skills among the matching users.
+	Teaching []FacetCount `json:"teaching"`
+}
+
+// SearchMessagesRequest defines model for SearchMessagesRequest.
+type SearchMessagesRequest struct {
+	// ConversationId Only search the messages of this conversation.
+	ConversationId *string `json:"conversation_id,omitempty"`
+
+	// Cursor Cursor returned as `next_cursor` by the previous search with the same parameters.
+	Cursor *string `json:"cursor,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *int32 `json:"pagesize,omitempty"`
+
+	// Query Words to look for in the texts of the messages. Words prefixed with `-` exclude the messages having them, quoted phrases must appear as they are.
+	Query string `json:"query"`
+
+	// Sender Only search the messages sent by this user.
+	Sender *string `json:"sender,omitempty"`
+
+	// Since Only search the messages sent at or after this time.
+	Since *time.Time `json:"since,omitempty"`
+
+	// Until Only search the messages sent before this time.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// SearchMode defines model for SearchMode.
+type SearchMode string
+
+// SearchRequest defines model for SearchRequest.
+type SearchRequest struct {
+	// Cursor Cursor returned as `next_cursor` by the previous search with the same parameters. Takes precedence over `page`.
+	Cursor *string `json:"cursor,omitempty"`
+
+	// FacetSize Maximum number of values in each facet.
+	FacetSize *int32 `json:"facet_size,omitempty"`
+
+	// IncludeFacets Whether to count the skills and languages of the matching users.
+	IncludeFacets *bool `json:"include_facets,omitempty"`
+
+	// IncludeTotal Whether to count the matching users.
+	IncludeTotal *bool `json:"include_total,omitempty"`
+
+	// Languages Only find users speaking at least one of these languages.
+	Languages *[]string   `json:"languages,omitempty"`
+	Mode      *SearchMode `json:"mode,omitempty"`
+
+	// Page Page number to retrieve.
+	Page *int32 `json:"page,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *int32 `json:"pagesize,omitempty"`
+
+	// Query Free text to look for in bios and skills. Results are sorted by relevance unless another sort is requested.
+	Query *string `json:"query,omitempty"`
+
+	// Skills Skills to search, taught or learned by the users found depending on `mode`.
+	Skills *[]string   `json:"skills,omitempty"`
+	Sort   *SearchSort `json:"sort,omitempty"`
+
+	// Username Only find users whose username starts with this, ignoring case.
+	Username *string `json:"username,omitempty"`
+
+	// UsernameInfix Find users whose username contains `username` anywhere instead. Searches shorter than 3 characters still match prefixes.
+	UsernameInfix *bool `json:"username_infix,omitempty"`
+}
+
+// SearchSort defines model for SearchSort.
+type SearchSort string
+
+// SendMessageRequest defines model for SendMessageRequest.
+type SendMessageRequest struct {
+	// AttachmentIds Identifiers of ready attachments the current user uploaded to the conversation and hasn't sent yet.
+	AttachmentIds *[]string `json:"attachment_ids,omitempty"`
+
+	// Text Text of the message, which may only be empty if it has attachments.
+	Text string `json:"text"`
+}
+
+// SetMemberRoleRequest defines model for SetMemberRoleRequest.
+type SetMemberRoleRequest struct {
+	Role GroupRole `json:"role"`
+}
+
+// SkillEndorsements defines model for SkillEndorsements.
+type SkillEndorsements struct {
+	// Count Number of users who endorsed the skill.
+	Count int64 `json:"count"`
+
+	// Skill Teaching skill.
+	Skill string `json:"skill"`
+}
+
+// SkillLevel defines model for SkillLevel.
+type SkillLevel struct {
+	// Level Proficiency in the skill, from 1 (beginner) to 5 (expert).
+	Level int32 `json:"level"`
+
+	// Skill Teaching skill.
+	Skill string `json:"skill"`
+}
+
+// StartConversationRequest defines model for StartConversationRequest.
+type StartConversationRequest struct {
+	// Username Username of the user to chat with.
+	Username string `json:"username"`
+}
+
+// Typing defines model for Typing.
+type Typing struct {
+	// ConversationId Identifier of the conversation.
+	ConversationId string `json:"conversation_id"`
+
+	// Username Username of the participant typing.
+	Username string `json:"username"`
+}
+
+// UnendorseRequest defines model for UnendorseRequest.
+type UnendorseRequest struct {
+	// Skill Skill to withdraw the endorsement of.
+	Skill string `json:"skill"`
+}
+
+// UnreadCount defines model for UnreadCount.
+type UnreadCount struct {
+	// ConversationId Identifier of the conversation.
+	ConversationId string `json:"conversation_id"`
+
+	// UnreadCount Number of messages the current user hasn't read yet.
+	UnreadCount int `json:"unread_count"`
+}
+
+// UnreadResponse defines model for UnreadResponse.
+type UnreadResponse struct {
+	// Conversations Conversations with unread messages.
+	Conversations []UnreadCount `json:"conversations"`
+
+	// Total Number of messages the current user hasn't read yet, across conversations.
+	Total int `json:"total"`
+}
+
+// UpdateGroupRequest defines model for UpdateGroupRequest.
+type UpdateGroupRequest struct {
+	// Description New description of the group.
+	Description *string `json:"description,omitempty"`
+
+	// MemberLimit New maximum number of members, which can't be lower than the current number.
+	MemberLimit *int `json:"member_limit,omitempty"`
+
+	// Name New name of the group.
+	Name *string `json:"name,omitempty"`
+
+	// Public Whether the group is a circle.
+	Public *bool `json:"public,omitempty"`
+}
+
+// UserProfile defines model for UserProfile.
+type UserProfile struct {
+	// Bio Short user biography.
+	Bio string `json:"bio"`
+
+	// Endorsements Endorsement counts of the teaching skills. Missing if the user hides their endorsements.
+	Endorsements *[]SkillEndorsements `json:"endorsements,omitempty"`
+
+	// Favourited Whether the current user has saved the user to their favourites. Only set in search results.
+	Favourited *bool `json:"favourited,omitempty"`
+
+	// Highlights Parts of the profile matching the search query. Only set in search results with a query.
+	Highlights *[]Highlight `json:"highlights,omitempty"`
+
+	// Languages Languages the user speaks.
+	Languages []string `json:"languages"`
+
+	// Learning Skills the user wants to learn.
+	Learning []string `json:"learning"`
+
+	// SkillLevels Proficiency in the teaching skills the user has rated.
+	SkillLevels *[]SkillLevel `json:"skill_levels,omitempty"`
+
+	// Teaching Skills the user is willing to teach.
+	Teaching []string `json:"teaching"`
+
+	// Username Username of the user.
 	Username string `json:"username"`
 }
 
+// CursorParam defines model for CursorParam.
+type CursorParam = string
+
+// PageParam defines model for PageParam.
+type PageParam = int32
+
+// PagesizeParam defines model for PagesizeParam.
+type PagesizeParam = int32
+
 // UsernameParam defines model for UsernameParam.
 type UsernameParam = string
 
+// AllowedSendersResponse defines model for AllowedSendersResponse.
+type AllowedSendersResponse struct {
+	// Users Allowed users, most recently allowed first.
+	Users []AllowedSender `json:"users"`
+}
+
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
+// BlockedUsersResponse defines model for BlockedUsersResponse.
+type BlockedUsersResponse struct {
+	Users []BlockedUser `json:"users"`
+}
+
 // Conflict defines model for Conflict.
 type Conflict = Error
 
+// ContactsResponse defines model for ContactsResponse.
+type ContactsResponse struct {
+	// Usernames Usernames of the user's contacts.
+	Usernames []string `json:"usernames"`
+}
+
+// ConversationsResponse defines model for ConversationsResponse.
+type ConversationsResponse struct {
+	// Conversations Conversations, most recently active first.
+	Conversations []Conversation `json:"conversations"`
+
+	// NextCursor Cursor to get the next page with. Only set if there are more conversations.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// EndorsementsResponse defines model for EndorsementsResponse.
+type EndorsementsResponse struct {
+	Endorsements []Endorsement `json:"endorsements"`
+}
+
+// FavouritesResponse defines model for FavouritesResponse.
+type FavouritesResponse struct {
+	Favourites []Favourite `json:"favourites"`
+}
+
+// Forbidden defines model for Forbidden.
+type Forbidden = Error
+
 // GetPictureResponse defines model for GetPictureResponse.
 type GetPictureResponse struct {
 	// Url URL to the user's avatar image.
 	Url string `json:"url"`
 }
 
+// MatchesResponse defines model for MatchesResponse.
+type MatchesResponse struct {
+	// Matches Matches, best first.
+	Matches []Match `json:"matches"`
+}
+
+// MessageSearchResponse defines model for MessageSearchResponse.
+type MessageSearchResponse struct {
+	// NextCursor Cursor to get the older messages found with. Only set if there are more.
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// Results Messages found, newest first.
+	Results []MessageSearchResult `json:"results"`
+}
+
+// MessagesResponse defines model for MessagesResponse.
+type MessagesResponse struct {
+	// Messages Messages, newest first.
+	Messages []Message `json:"messages"`
+
+	// NextCursor Cursor to get the older messages with. Only set if there are more messages.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ModerationFlagsResponse defines model for ModerationFlagsResponse.
+type ModerationFlagsResponse struct {
+	// Flags Flags, newest first.
+	Flags []ModerationFlag `json:"flags"`
+}
+
 // PongResponse defines model for PongResponse.
 type PongResponse struct {
 	// Message Pong message
 	Message string `json:"message"`
 }
 
+// ProfileInsightsResponse defines model for ProfileInsightsResponse.
+type ProfileInsightsResponse struct {
+	// Days Daily view counters, oldest first.
+	Days []ProfileViewsDay `json:"days"`
+
+	// RecentViewers Users who viewed the profile, most recent first. Listed once per day.
+	RecentViewers []ProfileViewer `json:"recent_viewers"`
+}
+
+// RecommendationsResponse defines model for RecommendationsResponse.
+type RecommendationsResponse struct {
+	// ComputedAt When the recommendations were computed. Missing if they haven't been yet.
+	ComputedAt *time.Time `json:"computed_at,omitempty"`
+
+	// Recommendations Recommended users, best first.
+	Recommendations []Recommendation `json:"recommendations"`
+}
+
+// SavedSearchesResponse defines model for SavedSearchesResponse.
+type SavedSearchesResponse struct {
+	SavedSearches []SavedSearch `json:"saved_searches"`
+}
+
+// SearchAlertsResponse defines model for SearchAlertsResponse.
+type SearchAlertsResponse struct {
+	// Alerts Alerts, newest first.
+	Alerts []SearchAlert `json:"alerts"`
+}
+
 // SearchResponse defines model for SearchResponse.
 type SearchResponse struct {
+	Facets *SearchFacets `json:"facets,omitempty"`
+
+	// HasMore Whether there are more users after this page.
+	HasMore bool `json:"has_more"`
+
+	// NextCursor Cursor to get the next page with. Only set if there are more users.
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// Total Approximate number of matching users, counting stops at 1000. Only set if requested.
+	Total *int64        `json:"total,omitempty"`
 	Users []UserProfile `json:"users"`
 }
 
@@ -140,86 +1219,1557 @@ type SetPictureResponse struct {
 	Url string `json:"url"`
 }
 
+// GetAlertsParams defines parameters for GetAlerts.
+type GetAlertsParams struct {
+	// Page Page number to retrieve.
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+
+	// Unread Only list alerts not marked as read.
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+}
+
+// GetAllowedSendersParams defines parameters for GetAllowedSenders.
+type GetAllowedSendersParams struct {
+	// Page Page number to retrieve.
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+}
+
+// GetBlocksParams defines parameters for GetBlocks.
+type GetBlocksParams struct {
+	// Page Page number to retrieve.
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+}
+
 // GetCheckUsernameParams defines parameters for GetCheckUsername.
 type GetCheckUsernameParams struct {
 	// Username Username to check.
 	Username UsernameParam `form:"username" json:"username"`
 }
 
+// PostContactsAddParams defines parameters for PostContactsAdd.
+type PostContactsAddParams struct {
+	// Username Username to check.
+	Username UsernameParam `form:"username" json:"username"`
+}
+
+// PostContactsRemoveParams defines parameters for PostContactsRemove.
+type PostContactsRemoveParams struct {
+	// Username Username to check.
+	Username UsernameParam `form:"username" json:"username"`
+}
+
+// GetConversationsParams defines parameters for GetConversations.
+type GetConversationsParams struct {
+	// Cursor Cursor returned as `next_cursor` with the previous page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+}
+
+// GetConversationsRequestsParams defines parameters for GetConversationsRequests.
+type GetConversationsRequestsParams struct {
+	// Cursor Cursor returned as `next_cursor` with the previous page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+}
+
+// GetConversationsIdMessagesParams defines parameters for GetConversationsIdMessages.
+type GetConversationsIdMessagesParams struct {
+	// Cursor Cursor returned as `next_cursor` with the previous page.
+	Cursor *CursorParam `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+}
+
+// DeleteConversationsIdMessagesMessageIdParams defines parameters for DeleteConversationsIdMessagesMessageId.
+type DeleteConversationsIdMessagesMessageIdParams struct {
+	Scope DeleteScope `form:"scope" json:"scope"`
+}
+
+// GetEventsParams defines parameters for GetEvents.
+type GetEventsParams struct {
+	// LastEventId Id of the last event received, when the `Last-Event-ID` header can't be set.
+	LastEventId *string `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
+}
+
+// GetFavouritesParams defines parameters for GetFavourites.
+type GetFavouritesParams struct {
+	// Page Page number to retrieve.
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+
+	// Tag Only list favourites with this tag.
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// GetMatchesParams defines parameters for GetMatches.
+type GetMatchesParams struct {
+	// Page Page number to retrieve.
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+}
+
+// GetModerationFlagsParams defines parameters for GetModerationFlags.
+type GetModerationFlagsParams struct {
+	// Page Page number to retrieve.
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+}
+
 // GetProfileGetPictureParams defines parameters for GetProfileGetPicture.
 type GetProfileGetPictureParams struct {
 	// Username Username to check.
 	Username UsernameParam `form:"username" json:"username"`
 }
 
+// GetProfileInsightsParams defines parameters for GetProfileInsights.
+type GetProfileInsightsParams struct {
+	// Days Number of days to get the insights for, including today.
+	Days *int32 `form:"days,omitempty" json:"days,omitempty"`
+}
+
 // PostProfileViewParams defines parameters for PostProfileView.
 type PostProfileViewParams struct {
 	// Username Username to check.
 	Username UsernameParam `form:"username" json:"username"`
 }
 
+// GetUsersUsernameEndorsementsParams defines parameters for GetUsersUsernameEndorsements.
+type GetUsersUsernameEndorsementsParams struct {
+	// Page Page number to retrieve.
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Pagesize Number of items to retrieve per page.
+	Pagesize *PagesizeParam `form:"pagesize,omitempty" json:"pagesize,omitempty"`
+
+	// Skill Only list endorsements of this skill.
+	Skill *string `form:"skill,omitempty" json:"skill,omitempty"`
+}
+
+// PostConversationsJSONRequestBody defines body for PostConversations for application/json ContentType.
+type PostConversationsJSONRequestBody = StartConversationRequest
+
+// PostConversationsIdAttachmentsJSONRequestBody defines body for PostConversationsIdAttachments for application/json ContentType.
+type PostConversationsIdAttachmentsJSONRequestBody = CreateAttachmentRequest
+
+// PostConversationsIdMessagesJSONRequestBody defines body for PostConversationsIdMessages for application/json ContentType.
+type PostConversationsIdMessagesJSONRequestBody = SendMessageRequest
+
+// PatchConversationsIdMessagesMessageIdJSONRequestBody defines body for PatchConversationsIdMessagesMessageId for application/json ContentType.
+type PatchConversationsIdMessagesMessageIdJSONRequestBody = EditMessageRequest
+
+// PostConversationsIdReadJSONRequestBody defines body for PostConversationsIdRead for application/json ContentType.
+type PostConversationsIdReadJSONRequestBody = MarkReadRequest
+
+// PostConversationsIdRequestJSONRequestBody defines body for PostConversationsIdRequest for application/json ContentType.
+type PostConversationsIdRequestJSONRequestBody = RespondMessageRequestRequest
+
+// PostGroupsJSONRequestBody defines body for PostGroups for application/json ContentType.
+type PostGroupsJSONRequestBody = CreateGroupRequest
+
+// PostGroupsJoinJSONRequestBody defines body for PostGroupsJoin for application/json ContentType.
+type PostGroupsJoinJSONRequestBody = JoinGroupRequest
+
+// PostGroupsSearchJSONRequestBody defines body for PostGroupsSearch for application/json ContentType.
+type PostGroupsSearchJSONRequestBody = SearchCirclesRequest
+
+// PatchGroupsIdJSONRequestBody defines body for PatchGroupsId for application/json ContentType.
+type PatchGroupsIdJSONRequestBody = UpdateGroupRequest
+
+// PostGroupsIdInvitesJSONRequestBody defines body for PostGroupsIdInvites for application/json ContentType.
+type PostGroupsIdInvitesJSONRequestBody = CreateInviteRequest
+
+// PutGroupsIdMembersUsernameJSONRequestBody defines body for PutGroupsIdMembersUsername for application/json ContentType.
+type PutGroupsIdMembersUsernameJSONRequestBody = SetMemberRoleRequest
+
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody = LoginRequest
 
-// PostProfileEditJSONRequestBody defines body for PostProfileEdit for application/json ContentType.
-type PostProfileEditJSONRequestBody = ProfileEditRequest
+// PostMessagesSearchJSONRequestBody defines body for PostMessagesSearch for application/json ContentType.
+type PostMessagesSearchJSONRequestBody = SearchMessagesRequest
+
+// PostProfileEditJSONRequestBody defines body for PostProfileEdit for application/json ContentType.
+type PostProfileEditJSONRequestBody = ProfileEditRequest
+
+// PostProfilePrivacyJSONRequestBody defines body for PostProfilePrivacy for application/json ContentType.
+type PostProfilePrivacyJSONRequestBody = PrivacyEditRequest
+
+// PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
+type PostRegisterJSONRequestBody = RegisterRequest
+
+// PostSavedSearchesJSONRequestBody defines body for PostSavedSearches for application/json ContentType.
+type PostSavedSearchesJSONRequestBody = SavedSearchRequest
+
+// PostSearchJSONRequestBody defines body for PostSearch for application/json ContentType.
+type PostSearchJSONRequestBody = SearchRequest
+
+// PostUsersUsernameEndorseJSONRequestBody defines body for PostUsersUsernameEndorse for application/json ContentType.
+type PostUsersUsernameEndorseJSONRequestBody = EndorseRequest
+
+// PostUsersUsernameFavouriteJSONRequestBody defines body for PostUsersUsernameFavourite for application/json ContentType.
+type PostUsersUsernameFavouriteJSONRequestBody = FavouriteRequest
+
+// PostUsersUsernameUnendorseJSONRequestBody defines body for PostUsersUsernameUnendorse for application/json ContentType.
+type PostUsersUsernameUnendorseJSONRequestBody = UnendorseRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the current user's saved search alerts
+	// (GET /alerts)
+	GetAlerts(c *gin.Context, params GetAlertsParams)
+	// Mark all of the current user's alerts as read
+	// (POST /alerts/read)
+	PostAlertsRead(c *gin.Context)
+	// List the users allowed to message the current user without a message request
+	// (GET /allowed-senders)
+	GetAllowedSenders(c *gin.Context, params GetAllowedSendersParams)
+	// List the users blocked by the current user
+	// (GET /blocks)
+	GetBlocks(c *gin.Context, params GetBlocksParams)
+	// Check if given username is available
+	// (GET /check-username)
+	GetCheckUsername(c *gin.Context, params GetCheckUsernameParams)
+	// List the current user's contacts
+	// (GET /contacts)
+	GetContacts(c *gin.Context)
+	// Add a user to the current user's contacts
+	// (POST /contacts/add)
+	PostContactsAdd(c *gin.Context, params PostContactsAddParams)
+	// Remove a user from the current user's contacts
+	// (POST /contacts/remove)
+	PostContactsRemove(c *gin.Context, params PostContactsRemoveParams)
+	// List the current user's conversations
+	// (GET /conversations)
+	GetConversations(c *gin.Context, params GetConversationsParams)
+	// Start a conversation with a user, or get the existing one
+	// (POST /conversations)
+	PostConversations(c *gin.Context)
+	// List the message requests waiting for the current user to answer, that have messages
+	// (GET /conversations/requests)
+	GetConversationsRequests(c *gin.Context, params GetConversationsRequestsParams)
+	// Count the messages the current user hasn't read yet
+	// (GET /conversations/unread)
+	GetConversationsUnread(c *gin.Context)
+	// Create an attachment in one of the current user's conversations, to upload a file to then send in a message
+	// (POST /conversations/{id}/attachments)
+	PostConversationsIdAttachments(c *gin.Context, id string)
+	// Get an attachment of one of the current user's conversations, with URLs to download it once ready
+	// (GET /conversations/{id}/attachments/{attachment_id})
+	GetConversationsIdAttachmentsAttachmentId(c *gin.Context, id string, attachmentId string)
+	// List the messages of one of the current user's conversations
+	// (GET /conversations/{id}/messages)
+	GetConversationsIdMessages(c *gin.Context, id string, params GetConversationsIdMessagesParams)
+	// Send a message in one of the current user's conversations
+	// (POST /conversations/{id}/messages)
+	PostConversationsIdMessages(c *gin.Context, id string)
+	// Delete a message of one of the current user's conversations, for everyone or for themselves
+	// (DELETE /conversations/{id}/messages/{message_id})
+	DeleteConversationsIdMessagesMessageId(c *gin.Context, id string, messageId string, params DeleteConversationsIdMessagesMessageIdParams)
+	// Edit a message the current user sent, within a while of sending it
+	// (PATCH /conversations/{id}/messages/{message_id})
+	PatchConversationsIdMessagesMessageId(c *gin.Context, id string, messageId string)
+	// Mark the messages of one of the current user's conversations as read, up to a message
+	// (POST /conversations/{id}/read)
+	PostConversationsIdRead(c *gin.Context, id string)
+	// Answer a message request to the current user
+	// (POST /conversations/{id}/request)
+	PostConversationsIdRequest(c *gin.Context, id string)
+	// Let the other participants of one of the current user's conversations know they are typing
+	// (POST /conversations/{id}/typing)
+	PostConversationsIdTyping(c *gin.Context, id string)
+	// Stream the current user's real-time events as Server-Sent Events, for clients that can't use WebSockets
+	// (GET /events)
+	GetEvents(c *gin.Context, params GetEventsParams)
+	// List the current user's favourites
+	// (GET /favourites)
+	GetFavourites(c *gin.Context, params GetFavouritesParams)
+	// Create a group about a skill, owned by the current user
+	// (POST /groups)
+	PostGroups(c *gin.Context)
+	// Join a group with an invite
+	// (POST /groups/join)
+	PostGroupsJoin(c *gin.Context)
+	// Find circles, the public groups, by skill
+	// (POST /groups/search)
+	PostGroupsSearch(c *gin.Context)
+	// Change the settings of a group the current user is an admin or the owner of
+	// (PATCH /groups/{id})
+	PatchGroupsId(c *gin.Context, id string)
+	// Create an invite to a group the current user is an admin or the owner of
+	// (POST /groups/{id}/invites)
+	PostGroupsIdInvites(c *gin.Context, id string)
+	// Join a circle
+	// (POST /groups/{id}/join)
+	PostGroupsIdJoin(c *gin.Context, id string)
+	// Leave a group
+	// (POST /groups/{id}/leave)
+	PostGroupsIdLeave(c *gin.Context, id string)
+	// Remove a member from a group the current user is an admin or the owner of
+	// (DELETE /groups/{id}/members/{username})
+	DeleteGroupsIdMembersUsername(c *gin.Context, id string, username string)
+	// Change the role of a member of a group the current user owns
+	// (PUT /groups/{id}/members/{username})
+	PutGroupsIdMembersUsername(c *gin.Context, id string, username string)
+	// Login a user
+	// (POST /login)
+	PostLogin(c *gin.Context)
+	// Logout the current user
+	// (POST /logout)
+	PostLogout(c *gin.Context)
+	// Find users to swap skills with, i.e. teaching what the current user learns and learning what they teach
+	// (GET /matches)
+	GetMatches(c *gin.Context, params GetMatchesParams)
+	// Search the messages of the current user's conversations
+	// (POST /messages/search)
+	PostMessagesSearch(c *gin.Context)
+	// List the messages and bios flagged by automated moderation, for moderators only
+	// (GET /moderation/flags)
+	GetModerationFlags(c *gin.Context, params GetModerationFlagsParams)
+	// Get a message along with its previous versions, for moderators only
+	// (GET /moderation/messages/{id}/history)
+	GetModerationMessagesIdHistory(c *gin.Context, id string)
+	// Ping the server
+	// (GET /ping)
+	GetPing(c *gin.Context)
+	// Edit the current user's profile
+	// (POST /profile/edit)
+	PostProfileEdit(c *gin.Context)
+	// Get link to the current user's profile picture
+	// (GET /profile/get_picture)
+	GetProfileGetPicture(c *gin.Context, params GetProfileGetPictureParams)
+	// Get who viewed the current user's profile
+	// (GET /profile/insights)
+	GetProfileInsights(c *gin.Context, params GetProfileInsightsParams)
+	// Get the current user's privacy settings
+	// (GET /profile/privacy)
+	GetProfilePrivacy(c *gin.Context)
+	// Edit the current user's privacy settings
+	// (POST /profile/privacy)
+	PostProfilePrivacy(c *gin.Context)
+	// Set the current user's profile picture
+	// (POST /profile/set_picture)
+	PostProfileSetPicture(c *gin.Context)
+	// View the user's profile
+	// (POST /profile/view)
+	PostProfileView(c *gin.Context, params PostProfileViewParams)
+	// List the users recommended to the current user, computed offline from skills and profiles
+	// (GET /recommendations)
+	GetRecommendations(c *gin.Context)
+	// Register a new user
+	// (POST /register)
+	PostRegister(c *gin.Context)
+	// List the current user's saved searches
+	// (GET /saved-searches)
+	GetSavedSearches(c *gin.Context)
+	// Save a search to be alerted about users newly matching it
+	// (POST /saved-searches)
+	PostSavedSearches(c *gin.Context)
+	// Delete a saved search along with its alerts
+	// (POST /saved-searches/{id}/delete)
+	PostSavedSearchesIdDelete(c *gin.Context, id string)
+	// Search for users
+	// (POST /search)
+	PostSearch(c *gin.Context)
+	// Allow a user to message the current user without a message request
+	// (POST /users/{username}/allow-messages)
+	PostUsersUsernameAllowMessages(c *gin.Context, username string)
+	// Block a user
+	// (POST /users/{username}/block)
+	PostUsersUsernameBlock(c *gin.Context, username string)
+	// Stop allowing a user to message the current user without a message request
+	// (POST /users/{username}/disallow-messages)
+	PostUsersUsernameDisallowMessages(c *gin.Context, username string)
+	// Endorse a teaching skill of a user, or update the comment of an endorsement
+	// (POST /users/{username}/endorse)
+	PostUsersUsernameEndorse(c *gin.Context, username string)
+	// List the endorsements of a user, newest first
+	// (GET /users/{username}/endorsements)
+	GetUsersUsernameEndorsements(c *gin.Context, username string, params GetUsersUsernameEndorsementsParams)
+	// Add a user to the current user's favourites, or update the note and tags of a favourite
+	// (POST /users/{username}/favourite)
+	PostUsersUsernameFavourite(c *gin.Context, username string)
+	// Unblock a user
+	// (POST /users/{username}/unblock)
+	PostUsersUsernameUnblock(c *gin.Context, username string)
+	// Withdraw an endorsement of a skill of a user
+	// (POST /users/{username}/unendorse)
+	PostUsersUsernameUnendorse(c *gin.Context, username string)
+	// Remove a user from the current user's favourites
+	// (POST /users/{username}/unfavourite)
+	PostUsersUsernameUnfavourite(c *gin.Context, username string)
+	// Open a WebSocket pushing the current user's real-time events, each as a RealtimeEvent in a text frame
+	// (GET /ws)
+	GetWs(c *gin.Context)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetAlerts operation middleware
+func (siw *ServerInterfaceWrapper) GetAlerts(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "unread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread", c.Request.URL.Query(), &params.Unread)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter unread: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAlerts(c, params)
+}
+
+// PostAlertsRead operation middleware
+func (siw *ServerInterfaceWrapper) PostAlertsRead(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAlertsRead(c)
+}
+
+// GetAllowedSenders operation middleware
+func (siw *ServerInterfaceWrapper) GetAllowedSenders(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllowedSendersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAllowedSenders(c, params)
+}
+
+// GetBlocks operation middleware
+func (siw *ServerInterfaceWrapper) GetBlocks(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBlocksParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetBlocks(c, params)
+}
+
+// GetCheckUsername operation middleware
+func (siw *ServerInterfaceWrapper) GetCheckUsername(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCheckUsernameParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := c.Query("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument username is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", c.Request.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCheckUsername(c, params)
+}
+
+// GetContacts operation middleware
+func (siw *ServerInterfaceWrapper) GetContacts(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetContacts(c)
+}
+
+// PostContactsAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostContactsAdd(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostContactsAddParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := c.Query("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument username is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", c.Request.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostContactsAdd(c, params)
+}
+
+// PostContactsRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostContactsRemove(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostContactsRemoveParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := c.Query("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument username is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", c.Request.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostContactsRemove(c, params)
+}
+
+// GetConversations operation middleware
+func (siw *ServerInterfaceWrapper) GetConversations(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConversationsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetConversations(c, params)
+}
+
+// PostConversations operation middleware
+func (siw *ServerInterfaceWrapper) PostConversations(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostConversations(c)
+}
+
+// GetConversationsRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetConversationsRequests(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConversationsRequestsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetConversationsRequests(c, params)
+}
+
+// GetConversationsUnread operation middleware
+func (siw *ServerInterfaceWrapper) GetConversationsUnread(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetConversationsUnread(c)
+}
+
+// PostConversationsIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) PostConversationsIdAttachments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostConversationsIdAttachments(c, id)
+}
+
+// GetConversationsIdAttachmentsAttachmentId operation middleware
+func (siw *ServerInterfaceWrapper) GetConversationsIdAttachmentsAttachmentId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", c.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attachment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetConversationsIdAttachmentsAttachmentId(c, id, attachmentId)
+}
+
+// GetConversationsIdMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetConversationsIdMessages(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConversationsIdMessagesParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetConversationsIdMessages(c, id, params)
+}
+
+// PostConversationsIdMessages operation middleware
+func (siw *ServerInterfaceWrapper) PostConversationsIdMessages(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostConversationsIdMessages(c, id)
+}
+
+// DeleteConversationsIdMessagesMessageId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConversationsIdMessagesMessageId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "message_id" -------------
+	var messageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "message_id", c.Param("message_id"), &messageId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter message_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteConversationsIdMessagesMessageIdParams
+
+	// ------------- Required query parameter "scope" -------------
+
+	if paramValue := c.Query("scope"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument scope is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "scope", c.Request.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteConversationsIdMessagesMessageId(c, id, messageId, params)
+}
+
+// PatchConversationsIdMessagesMessageId operation middleware
+func (siw *ServerInterfaceWrapper) PatchConversationsIdMessagesMessageId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "message_id" -------------
+	var messageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "message_id", c.Param("message_id"), &messageId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter message_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchConversationsIdMessagesMessageId(c, id, messageId)
+}
+
+// PostConversationsIdRead operation middleware
+func (siw *ServerInterfaceWrapper) PostConversationsIdRead(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostConversationsIdRead(c, id)
+}
+
+// PostConversationsIdRequest operation middleware
+func (siw *ServerInterfaceWrapper) PostConversationsIdRequest(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostConversationsIdRequest(c, id)
+}
+
+// PostConversationsIdTyping operation middleware
+func (siw *ServerInterfaceWrapper) PostConversationsIdTyping(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostConversationsIdTyping(c, id)
+}
+
+// GetEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetEvents(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsParams
+
+	// ------------- Optional query parameter "last_event_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "last_event_id", c.Request.URL.Query(), &params.LastEventId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter last_event_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEvents(c, params)
+}
+
+// GetFavourites operation middleware
+func (siw *ServerInterfaceWrapper) GetFavourites(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFavouritesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetFavourites(c, params)
+}
+
+// PostGroups operation middleware
+func (siw *ServerInterfaceWrapper) PostGroups(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostGroups(c)
+}
+
+// PostGroupsJoin operation middleware
+func (siw *ServerInterfaceWrapper) PostGroupsJoin(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostGroupsJoin(c)
+}
+
+// PostGroupsSearch operation middleware
+func (siw *ServerInterfaceWrapper) PostGroupsSearch(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostGroupsSearch(c)
+}
+
+// PatchGroupsId operation middleware
+func (siw *ServerInterfaceWrapper) PatchGroupsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchGroupsId(c, id)
+}
+
+// PostGroupsIdInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostGroupsIdInvites(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostGroupsIdInvites(c, id)
+}
+
+// PostGroupsIdJoin operation middleware
+func (siw *ServerInterfaceWrapper) PostGroupsIdJoin(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostGroupsIdJoin(c, id)
+}
+
+// PostGroupsIdLeave operation middleware
+func (siw *ServerInterfaceWrapper) PostGroupsIdLeave(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostGroupsIdLeave(c, id)
+}
+
+// DeleteGroupsIdMembersUsername operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGroupsIdMembersUsername(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteGroupsIdMembersUsername(c, id, username)
+}
+
+// PutGroupsIdMembersUsername operation middleware
+func (siw *ServerInterfaceWrapper) PutGroupsIdMembersUsername(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutGroupsIdMembersUsername(c, id, username)
+}
+
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostLogin(c)
+}
+
+// PostLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostLogout(c)
+}
+
+// GetMatches operation middleware
+func (siw *ServerInterfaceWrapper) GetMatches(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMatchesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMatches(c, params)
+}
+
+// PostMessagesSearch operation middleware
+func (siw *ServerInterfaceWrapper) PostMessagesSearch(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMessagesSearch(c)
+}
+
+// GetModerationFlags operation middleware
+func (siw *ServerInterfaceWrapper) GetModerationFlags(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModerationFlagsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetModerationFlags(c, params)
+}
+
+// GetModerationMessagesIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetModerationMessagesIdHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetModerationMessagesIdHistory(c, id)
+}
+
+// GetPing operation middleware
+func (siw *ServerInterfaceWrapper) GetPing(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPing(c)
+}
+
+// PostProfileEdit operation middleware
+func (siw *ServerInterfaceWrapper) PostProfileEdit(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProfileEdit(c)
+}
+
+// GetProfileGetPicture operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileGetPicture(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileGetPictureParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := c.Query("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument username is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", c.Request.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfileGetPicture(c, params)
+}
+
+// GetProfileInsights operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileInsights(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileInsightsParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfileInsights(c, params)
+}
+
+// GetProfilePrivacy operation middleware
+func (siw *ServerInterfaceWrapper) GetProfilePrivacy(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfilePrivacy(c)
+}
 
-// PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
-type PostRegisterJSONRequestBody = RegisterRequest
+// PostProfilePrivacy operation middleware
+func (siw *ServerInterfaceWrapper) PostProfilePrivacy(c *gin.Context) {
 
-// PostSearchJSONRequestBody defines body for PostSearch for application/json ContentType.
-type PostSearchJSONRequestBody = SearchRequest
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Check if given username is available
-	// (GET /check-username)
-	GetCheckUsername(c *gin.Context, params GetCheckUsernameParams)
-	// Login a user
-	// (POST /login)
-	PostLogin(c *gin.Context)
-	// Logout the current user
-	// (POST /logout)
-	PostLogout(c *gin.Context)
-	// Ping the server
-	// (GET /ping)
-	GetPing(c *gin.Context)
-	// Edit the current user's profile
-	// (POST /profile/edit)
-	PostProfileEdit(c *gin.Context)
-	// Get link to the current user's profile picture
-	// (GET /profile/get_picture)
-	GetProfileGetPicture(c *gin.Context, params GetProfileGetPictureParams)
-	// Set the current user's profile picture
-	// (POST /profile/set_picture)
-	PostProfileSetPicture(c *gin.Context)
-	// View the user's profile
-	// (POST /profile/view)
-	PostProfileView(c *gin.Context, params PostProfileViewParams)
-	// Register a new user
-	// (POST /register)
-	PostRegister(c *gin.Context)
-	// Search for users
-	// (POST /search)
-	PostSearch(c *gin.Context)
+	siw.Handler.PostProfilePrivacy(c)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
-}
+// PostProfileSetPicture operation middleware
+func (siw *ServerInterfaceWrapper) PostProfileSetPicture(c *gin.Context) {
 
-type MiddlewareFunc func(c *gin.Context)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// GetCheckUsername operation middleware
-func (siw *ServerInterfaceWrapper) GetCheckUsername(c *gin.Context) {
+	siw.Handler.PostProfileSetPicture(c)
+}
+
+// PostProfileView operation middleware
+func (siw *ServerInterfaceWrapper) PostProfileView(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCheckUsernameParams
+	var params PostProfileViewParams
 
 	// ------------- Required query parameter "username" -------------
 
@@ -243,11 +2793,11 @@ func (siw *ServerInterfaceWrapper) GetCheckUsername(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCheckUsername(c, params)
+	siw.Handler.PostProfileView(c, params)
 }
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+// GetRecommendations operation middleware
+func (siw *ServerInterfaceWrapper) GetRecommendations(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -256,11 +2806,11 @@ func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogin(c)
+	siw.Handler.GetRecommendations(c)
 }
 
-// PostLogout operation middleware
-func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -269,11 +2819,11 @@ func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogout(c)
+	siw.Handler.PostRegister(c)
 }
 
-// GetPing operation middleware
-func (siw *ServerInterfaceWrapper) GetPing(c *gin.Context) {
+// GetSavedSearches operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedSearches(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -282,11 +2832,11 @@ func (siw *ServerInterfaceWrapper) GetPing(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetPing(c)
+	siw.Handler.GetSavedSearches(c)
 }
 
-// PostProfileEdit operation middleware
-func (siw *ServerInterfaceWrapper) PostProfileEdit(c *gin.Context) {
+// PostSavedSearches operation middleware
+func (siw *ServerInterfaceWrapper) PostSavedSearches(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -295,27 +2845,79 @@ func (siw *ServerInterfaceWrapper) PostProfileEdit(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProfileEdit(c)
+	siw.Handler.PostSavedSearches(c)
 }
 
-// GetProfileGetPicture operation middleware
-func (siw *ServerInterfaceWrapper) GetProfileGetPicture(c *gin.Context) {
+// PostSavedSearchesIdDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostSavedSearchesIdDelete(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProfileGetPictureParams
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	// ------------- Required query parameter "username" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	if paramValue := c.Query("username"); paramValue != "" {
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument username is required, but not found"), http.StatusBadRequest)
+	siw.Handler.PostSavedSearchesIdDelete(c, id)
+}
+
+// PostSearch operation middleware
+func (siw *ServerInterfaceWrapper) PostSearch(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSearch(c)
+}
+
+// PostUsersUsernameAllowMessages operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUsernameAllowMessages(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "username", c.Request.URL.Query(), &params.Username)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersUsernameAllowMessages(c, username)
+}
+
+// PostUsersUsernameBlock operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUsernameBlock(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
 		return
@@ -328,11 +2930,22 @@ func (siw *ServerInterfaceWrapper) GetProfileGetPicture(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetProfileGetPicture(c, params)
+	siw.Handler.PostUsersUsernameBlock(c, username)
 }
 
-// PostProfileSetPicture operation middleware
-func (siw *ServerInterfaceWrapper) PostProfileSetPicture(c *gin.Context) {
+// PostUsersUsernameDisallowMessages operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUsernameDisallowMessages(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -341,27 +2954,93 @@ func (siw *ServerInterfaceWrapper) PostProfileSetPicture(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProfileSetPicture(c)
+	siw.Handler.PostUsersUsernameDisallowMessages(c, username)
 }
 
-// PostProfileView operation middleware
-func (siw *ServerInterfaceWrapper) PostProfileView(c *gin.Context) {
+// PostUsersUsernameEndorse operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUsernameEndorse(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersUsernameEndorse(c, username)
+}
+
+// GetUsersUsernameEndorsements operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUsernameEndorsements(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostProfileViewParams
+	var params GetUsersUsernameEndorsementsParams
 
-	// ------------- Required query parameter "username" -------------
+	// ------------- Optional query parameter "page" -------------
 
-	if paramValue := c.Query("username"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument username is required, but not found"), http.StatusBadRequest)
+	// ------------- Optional query parameter "pagesize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pagesize", c.Request.URL.Query(), &params.Pagesize)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pagesize: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "username", c.Request.URL.Query(), &params.Username)
+	// ------------- Optional query parameter "skill" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "skill", c.Request.URL.Query(), &params.Skill)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter skill: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersUsernameEndorsements(c, username, params)
+}
+
+// PostUsersUsernameFavourite operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUsernameFavourite(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
 		return
@@ -374,11 +3053,22 @@ func (siw *ServerInterfaceWrapper) PostProfileView(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProfileView(c, params)
+	siw.Handler.PostUsersUsernameFavourite(c, username)
 }
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+// PostUsersUsernameUnblock operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUsernameUnblock(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -387,11 +3077,22 @@ func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostRegister(c)
+	siw.Handler.PostUsersUsernameUnblock(c, username)
 }
 
-// PostSearch operation middleware
-func (siw *ServerInterfaceWrapper) PostSearch(c *gin.Context) {
+// PostUsersUsernameUnendorse operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUsernameUnendorse(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -400,7 +3101,44 @@ func (siw *ServerInterfaceWrapper) PostSearch(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSearch(c)
+	siw.Handler.PostUsersUsernameUnendorse(c, username)
+}
+
+// PostUsersUsernameUnfavourite operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUsernameUnfavourite(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersUsernameUnfavourite(c, username)
+}
+
+// GetWs operation middleware
+func (siw *ServerInterfaceWrapper) GetWs(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWs(c)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -430,48 +3168,276 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/alerts", wrapper.GetAlerts)
+	router.POST(options.BaseURL+"/alerts/read", wrapper.PostAlertsRead)
+	router.GET(options.BaseURL+"/allowed-senders", wrapper.GetAllowedSenders)
+	router.GET(options.BaseURL+"/blocks", wrapper.GetBlocks)
 	router.GET(options.BaseURL+"/check-username", wrapper.GetCheckUsername)
+	router.GET(options.BaseURL+"/contacts", wrapper.GetContacts)
+	router.POST(options.BaseURL+"/contacts/add", wrapper.PostContactsAdd)
+	router.POST(options.BaseURL+"/contacts/remove", wrapper.PostContactsRemove)
+	router.GET(options.BaseURL+"/conversations", wrapper.GetConversations)
+	router.POST(options.BaseURL+"/conversations", wrapper.PostConversations)
+	router.GET(options.BaseURL+"/conversations/requests", wrapper.GetConversationsRequests)
+	router.GET(options.BaseURL+"/conversations/unread", wrapper.GetConversationsUnread)
+	router.POST(options.BaseURL+"/conversations/:id/attachments", wrapper.PostConversationsIdAttachments)
+	router.GET(options.BaseURL+"/conversations/:id/attachments/:attachment_id", wrapper.GetConversationsIdAttachmentsAttachmentId)
+	router.GET(options.BaseURL+"/conversations/:id/messages", wrapper.GetConversationsIdMessages)
+	router.POST(options.BaseURL+"/conversations/:id/messages", wrapper.PostConversationsIdMessages)
+	router.DELETE(options.BaseURL+"/conversations/:id/messages/:message_id", wrapper.DeleteConversationsIdMessagesMessageId)
+	router.PATCH(options.BaseURL+"/conversations/:id/messages/:message_id", wrapper.PatchConversationsIdMessagesMessageId)
+	router.POST(options.BaseURL+"/conversations/:id/read", wrapper.PostConversationsIdRead)
+	router.POST(options.BaseURL+"/conversations/:id/request", wrapper.PostConversationsIdRequest)
+	router.POST(options.BaseURL+"/conversations/:id/typing", wrapper.PostConversationsIdTyping)
+	router.GET(options.BaseURL+"/events", wrapper.GetEvents)
+	router.GET(options.BaseURL+"/favourites", wrapper.GetFavourites)
+	router.POST(options.BaseURL+"/groups", wrapper.PostGroups)
+	router.POST(options.BaseURL+"/groups/join", wrapper.PostGroupsJoin)
+	router.POST(options.BaseURL+"/groups/search", wrapper.PostGroupsSearch)
+	router.PATCH(options.BaseURL+"/groups/:id", wrapper.PatchGroupsId)
+	router.POST(options.BaseURL+"/groups/:id/invites", wrapper.PostGroupsIdInvites)
+	router.POST(options.BaseURL+"/groups/:id/join", wrapper.PostGroupsIdJoin)
+	router.POST(options.BaseURL+"/groups/:id/leave", wrapper.PostGroupsIdLeave)
+	router.DELETE(options.BaseURL+"/groups/:id/members/:username", wrapper.DeleteGroupsIdMembersUsername)
+	router.PUT(options.BaseURL+"/groups/:id/members/:username", wrapper.PutGroupsIdMembersUsername)
 	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
 	router.POST(options.BaseURL+"/logout", wrapper.PostLogout)
+	router.GET(options.BaseURL+"/matches", wrapper.GetMatches)
+	router.POST(options.BaseURL+"/messages/search", wrapper.PostMessagesSearch)
+	router.GET(options.BaseURL+"/moderation/flags", wrapper.GetModerationFlags)
+	router.GET(options.BaseURL+"/moderation/messages/:id/history", wrapper.GetModerationMessagesIdHistory)
 	router.GET(options.BaseURL+"/ping", wrapper.GetPing)
 	router.POST(options.BaseURL+"/profile/edit", wrapper.PostProfileEdit)
 	router.GET(options.BaseURL+"/profile/get_picture", wrapper.GetProfileGetPicture)
+	router.GET(options.BaseURL+"/profile/insights", wrapper.GetProfileInsights)
+	router.GET(options.BaseURL+"/profile/privacy", wrapper.GetProfilePrivacy)
+	router.POST(options.BaseURL+"/profile/privacy", wrapper.PostProfilePrivacy)
 	router.POST(options.BaseURL+"/profile/set_picture", wrapper.PostProfileSetPicture)
 	router.POST(options.BaseURL+"/profile/view", wrapper.PostProfileView)
+	router.GET(options.BaseURL+"/recommendations", wrapper.GetRecommendations)
 	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
+	router.GET(options.BaseURL+"/saved-searches", wrapper.GetSavedSearches)
+	router.POST(options.BaseURL+"/saved-searches", wrapper.PostSavedSearches)
+	router.POST(options.BaseURL+"/saved-searches/:id/delete", wrapper.PostSavedSearchesIdDelete)
 	router.POST(options.BaseURL+"/search", wrapper.PostSearch)
+	router.POST(options.BaseURL+"/users/:username/allow-messages", wrapper.PostUsersUsernameAllowMessages)
+	router.POST(options.BaseURL+"/users/:username/block", wrapper.PostUsersUsernameBlock)
+	router.POST(options.BaseURL+"/users/:username/disallow-messages", wrapper.PostUsersUsernameDisallowMessages)
+	router.POST(options.BaseURL+"/users/:username/endorse", wrapper.PostUsersUsernameEndorse)
+	router.GET(options.BaseURL+"/users/:username/endorsements", wrapper.GetUsersUsernameEndorsements)
+	router.POST(options.BaseURL+"/users/:username/favourite", wrapper.PostUsersUsernameFavourite)
+	router.POST(options.BaseURL+"/users/:username/unblock", wrapper.PostUsersUsernameUnblock)
+	router.POST(options.BaseURL+"/users/:username/unendorse", wrapper.PostUsersUsernameUnendorse)
+	router.POST(options.BaseURL+"/users/:username/unfavourite", wrapper.PostUsersUsernameUnfavourite)
+	router.GET(options.BaseURL+"/ws", wrapper.GetWs)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZT2/buBL/KgTfA95FkZO2l+eih7QouukWrRG3u4egWNDSWGIjkSo5cuoG+u6LISVZ",
-	"imRHySZZ7N4ckRzO7zd/ODO55pHOC61AoeXza14II3JAMO6vLxaMEjks6Ct9iMFGRhYoteLzdpmhZlEK",
-	"0WXIAy5p5XsJZssDTqt8zst6Iw+4ge+lNBDzOZoSAm6jFHJBsnFb0F6LRqqEV1VFm22hlQWnzGsRn8P3",
-	"EizSX5FWCMr9FEWRyUiQUrNvljS77oj9r4E1n/P/zHZAZ37Vzt4ao42/qo/scwrM+MuY3SoUP5i0TKqN",
-	"yGTMtGF0vZBq921HXMirgL8hOhp+zmsYD6b3uPQRHM1aayHWmoKU1GqdyeiJ+YzqWy27kpgyTIFFpTGg",
-	"kFkUCEyv3UcUJgFkBqwuTQSO1neACxlhae7HaWF0AQald6jSZCM+ff6ByCIFiKr/WSY2AoVhMhcJkIOv",
-	"tckFklebjAcDt+26+IXb87XdpFffIMLbDEWou6zUehRGr2UGrPAEEB0LrZIHICIHa0UCQzJIPmtWb4Pa",
-	"7JsCtye4CvgShInSh7CprVOXRMjtbf5KIbTwtJIWtdrCGLEdWtJJvqstrQPG/GEHFE9L7CHtH14CMlFi",
-	"CgprzCzS+lIS/ymIuIa3BDx647/32IAfIi8yh6LE9LO+BPUKtu/T1btIfpLvz778DMPwJVsITF/NXrJf",
-	"EItPKtu+ZEuRw1IivFqioZQwtHbl1f/3x5+dGH9V83o5NHszfh+02AiZiVU2YvrfU8AUTIvdPa3SEeCP",
-	"0MOjNIY71CutMxBqAHt3yxB8wH2aHqgW6XhEq1PFOkY9sgVEci0jBiSE0ZlwaAWiF4XM7FDeJ/dDZEzE",
-	"sax/1puZWOnSc++kdwTvlN+brE5ZWuZCHRkQsWOrs9y8KTfF7vEax8QYcx90IlWnCukTWAhrr7SJRxJp",
-	"vULulZGMUc7at/lgpbXv/Ei+qmuuVq8xSHX+extL3AtsJfVIpkq18cHBVlInRhTpdhRWBsIo+j0UcSmz",
-	"zLYOz66EQusw0hFXTTZpfCC1n6yDA+R/hCvWrLK13gVYL5u050cQIIgonYRAUlWTZVIlLovRubvAqEYM",
-	"dA6JtAjmbtZ5LTVpYOrD9zTMA1uiGwYHNbud8HuQOzXADmg2IcYCZ48OhA7PY/HXlD57c0qT69aizJDP",
-	"jwe1lEiAqTJf0dNB2qORsOk/lVLh82c84LlUMi9zJ6XWRCqEBIw3XAJW/uzfdzK48KO/S6+ZY757KSvA",
-	"sOLmQ93eLn7420lmq8rJmCrWmbmnyMXXYK8v+DLrrzhDfQnnwQHP2F0z9IuBYbvF5T8yoz5Z1psUmPUL",
-	"3uTtyYF5l2gkx4OoNBK3fH5xzX2FTQU7uV/1dbe8pMLPm7K76dqPP9qKvZ5/tLX4TnFRyF9h60tRqdYj",
-	"3nC6OHOvlWBFJpCCiVpnBRESz66jcD6ZCgPMxwvbSMGiVCATKmY5AG21ji6JritwltuyRSPxdHHGA74B",
-	"Y/2lJ+FxeEwW0QUoUUg+58/Dk/DYZThMHeCZmyQcdW2WgEtd5OGuTDyL+Zya9V5R7GTsxksX473Zbsus",
-	"P34i+nsDoWfHx/savHbf3lFJz9Jk2DLPhdnyuS/kmVyzRG5AjVfiTsDMVWIutrUdwb/QFl3BWI+9wOJr",
-	"HW8fbNrSK0arfgigKaG6D183G9Qq4C+mnOtM5w6R63RmwrHakqhLvJVF2jPA82IYNX4rs2UUgbXrMgvZ",
-	"R81qvumdKo2COGQEsu6sybIxZIAQh175rrpNR9LtBr3mRZ0b9zn/wuebu9ugN9Y5xObCZdwUmAWzadXy",
-	"b84MYnkLrZ3S/5FcdKS5mO6oD6JBb8QzMoiqm/qyiAVC3PGbbBuyc+cutl11j13N701XIYwHxgZ92ySA",
-	"fzRzhEMe5Lfvpp5/SwodGbr2ob8DZJlUl83gZsLgpGXC9pm41VmXXS7uld4OY1lOH/60GDYSriYp/xtt",
-	"fBwTPmWwNOOapuofpE3C2R3h9WKgaa8OM9Z0vY+UmG421ZOy0snTPJ905P8TKpvmvzeHXogGJxNMwVXn",
-	"8fKtzGEb+Nb0kSzQ73sfrnzp/SPhZmy7YTwV1fVAvqqqPwMAAP//U9KVawIdAAA=",
+	"H4sIAAAAAAAC/+x9a3PcuLHoX0Hx3qqcU0XL8m58cq+2zgfH+4gTb6Ky7JObirckiMTMYM0BGALUeLLl",
+	"/36ruwESJMHHjEays5VPtoYk0OhuNBr9/CXJ9LbUSihrkotfkpJXfCusqPCvl3VldHUJv8GfuTBZJUsr",
+	"tUou3ENWCVtXSuSMG3ajxEd7neGDG7aTdsPsRrCyEndS14aVfC3OkjSR8P0/alHtkzRRfCuSi4S+StLE",
+	"ZBux5TCf3ZfwxNhKqnXy6VOaXPK1GAEHHjFVb29FxawGsCop7kanA1A6k+VixevCJhfnabLS1Zbb5CKR",
+	"yn79VZImW6nktt7iQweVVFasRdWAZeQ/x0D7M0GlV0xasTUheKwU1SRaSjd0HNZnUWD5RwL2+XkA+bMo",
+	"5O+MqGCmEcj9YwA524jswxiYtXsxSZNK/KOWlciTC1vVYoqen+BlU2plBLLbi6LQO5FfCZWLyrxxj+BJ",
+	"ppUVysJ/eVkWMuMA4NOfDUD5SzBFWelSVFbSgACVGa7KzcPwccq22lhWiUwoW+wZdw9XsjIWlws0g0H+",
+	"dyVWyUXyv562W+YpzWyedkBPPjW45lXF98mnTyFa/u7g+ql5S9/+LDJLGOmC6pEABCiksbif8PMGUKvZ",
+	"VhgD7A8Ps7qqhLL4Em5BXVvGGUwvjAXQfs/zN+6vQ1A7tfzvqkpXMfjfboSfmpm9svwjk4ZJdccLmTNd",
+	"MZieS9X+1kqgMwS20NkHkb8zJ+aIRVQNJn8cmt7ShOx2P6AlAPAStqDfk0dhY2q18dFnFoBSgTXbH4DU",
+	"alXI7JGZK3OzmvbY8dgzllsB0hd+tLxaC8sqYXRdZeLMQWx5Zk/FX4AIMy5LjQcF3v2NoR2QWdORNT1R",
+	"uYD3aNaj+S9ktQAoh547URlEwClwlIXjRfSK8PFAOmdW3okDhXM44hCXaRJoLaNqjtVsLQhV8Dqe2chq",
+	"Z+wvqtgzIyyTSNdKMF4JttWVYJ2lniXN1IFKE9Kx8/opaRmM+ilNvlO5rozYApJOQE8RDLdYtAYwzLJ3",
+	"Z4KjsRKOAluQN2L1e36n60pacQpsrJrBFuOimX8WE8Hgp+KOYEhAha5uZZ4L9TjSO4SFScOUtqFew7NM",
+	"GINQOzkv8q7o/kHYS5nZuhKnEN5VERHbb14DKIHA5nfc8orJrVPbGwUcvp/b4vDOoaTzgqdHubLSK1kI",
+	"VhICAB0/cpttTsLGWxppiA83RcpuhbEHCmL8dpbJ/dSHomklVc7MB1kUzOx4ydw4bKWrAfoQWaQ1Xwle",
+	"ZZsToOzAY0QXuai86g5Q1iqfPVEiZwheourCxmjVGTxlSuyOIFoPTXDxnCOhh+hQEhqcBNHTIEavBtSL",
+	"HWp+rafgfTfUOELvh8oT6CA95plVRPyb8zpIs/ijD5iQcrxDKKSTzkWFf31f8PVJTlwYZ4g0HP5YQnWA",
+	"nD+WEYKjEYafs4pLQ1c/Xlu95XDWbRs4AIZLrdanY++ICU2rtafeUj5ZtOrOwLAQOrleKSPXm5PooDnf",
+	"R1jgWy6LPbuTYscyXSuL5h7YOgczhIP4f6TYmW9px/Z3MN1RrmE2UY3c/wzbbTQCJHJnGsVxO9ccBxp7",
+	"LVHf0SojM2HO98fAu8B2gdgbrODEagqSQTqSA0hvRKa3W6HyE94st2VtRX7N7RD/f90I5VTJzrxsJ/Cm",
+	"Rp+esR+lMVKtnRDdsw2/E+o3lt0Kodhe2I7Kl3Mrnli5FfFzuTPREKQGA60x8gitqovHBWdzF6p7mqyq",
+	"YA1WDxgAwLnid2AahaP9JOezgfGujRtw8TUrAGMWSb0pTnXZwmFZMyzgBv//ohDVSeQgx4FiJm/4/cjT",
+	"MIBxFnEOgIdAGHNjN1g7yW09E3YhAr6ndz+lyYaba1CrokLGbkTVV7+cxX5l8ZFsPWEOSbdaF4KrhzVG",
+	"IRDRC4TVlkeuvi/KstIfJagi3rOmV3StAgHpJBaerPC3sbo0jFv27Pz8vAtJc3vvCE+p7H/9Nhn6pdID",
+	"zfRwsroDb6GZPiDhkVcUGgdZ0b6obYcXux9fCQsa3UYo67iSZVp/kAKgEDx3K70S9slL+r3Dr+Ij35YF",
+	"Lqi2m7f6g1D/LfZ/3Nz+kMm/yD++evfPs7Ozb9glt5v/fvoN+4O1JWD+G3bFt+JKWvHfV7aSmY0odJ8I",
+	"/F+/AcUsNKB88t7KoTsyJmrx8bSuQV443njrlisPjUtl3Ckb+BGO8wbO3wYDv26w3iH+0+SFtTzbbB3X",
+	"DCz+wE7X9FF/PW/3ZbMWpAc3LBdWZBbcsJXeMmnJIyGUTUkfRrcTaGuvtnQfv/z2+5SVBZeKWfHRpuz/",
+	"/fiacZWzf8qS3XIjcpbrrCbrL68EM3VZ6sqJpAH2w1vrtcyHQL/KYT+vpKg86OEn+ANvMIIcUJeF5qQl",
+	"xaesBJ/VXXtjum+WcxXgN85Vfw44Ct6KwghSE6M5xG787OPMbHlRiIr98fK7H5h734+Nu55JlAKy4LfF",
+	"yDm4DOktPqLwYuTEUCLLf3YZTip2u7fC9JgrejQZy209Hw/QwHVF78OOJgao5nd0ySsrM1lyIPNGB6wz",
+	"t+Te9pV5MuTlAJKAIZqVdanc4cvpfR8eID1R2ZENy/AGYzsgrqPnymUljFwrkTN3wuR6p2BdbcyR2KWM",
+	"ogrABvzsOdtKVSOdG+1IGqZVnNmPmJXu8vEpkbUqwfP9PN0ChE3j/KrhxsFerERfYsCmsxfvFWNPWClU",
+	"LtX6gu24RO3NW8lxQ1jNbkXLdiBH3ab4hj7HdVy0H6Awojfoba5gBAPTwt8eS8EAP6OA741htWYFr9aC",
+	"adzjXLFaNaKaASZSHHCHh0QhQPq9V0maCAVRTn9P3MISQCjP9/gvTZX8NEB7mryocylUFlXlNWKsPViN",
+	"EIyzUopM9Hz4TpM4C+AQd6LaayVoA5IzPU2UvtX5PgpJGGwy2EEuMGShsuHefghlww2Ncx2kPgQriLH0",
+	"aGhLT5L4Q2Py6tXEo3TOGWAppW3suOlvv2aWKKiyyooIbB1whtBxp4Pi1wDYSldRubPs5FtXui6j3/+s",
+	"pRL5NIL6jlfOtgIuePGzmJ5d401vKryR3jPxg9MNUsittDG3IgYthvfMqcHmdRjCc1wrAB9hRC2An3s0",
+	"4re6XnrO+mMUR+9eR3oo7CGjIdk4t5nxLUHAxgJp6EE/hMad54fG0OBgjxw9QwuYR797MY6/0P80RN4S",
+	"pbuj2YN8NZZXB6nduFnnUPwDvrRYAIRQRdn8g1T5IaFRf4L3P6VJwY29DpxECz2acNxGuPA1N7a5ksI7",
+	"4OESPNuEWm7q1A9tBCq8YHOnl7naH2AIh8jWTMjSxh00TdDrgiW9aQNmawWgLBF/zvE5kLAbbsCFgCty",
+	"LoSI0avM53mxCNGJvEiX4mrIqY5LYWNJCyAw5VTd5UrBQaa3mFBEJuxcIjoL7SHXc9HcRv6TY+2hxjbA",
+	"gjTsVtidEMppvrmsRGYvhkQCtZIrjWekViJlN/DzjVNYcQtfOAc3nkxA8hv8+aargtIMid/2MWXvJeIj",
+	"vDs1vNkzUC++sKcMuGGjd8qb24INhkJ0yz++FmptN8nFV8+fY26A//vZKS7P3NJR8+yc/Sh/fxamIjw7",
+	"/+3/ef67/5pPSOi41YO7KcAS5YoBIk99CaVb0MI76OW7t+EtKrwLNlfBM/bWvwHXJA0HYHjbgovihfsT",
+	"HZGW8TWX6r0iB4K0nesWXW9M3zaAHLn4ltlZ5jie8Yga5dWBAuyyVJIkHdOGcYcEynDAos/PzyNMOdQi",
+	"3STPz/uTjKqUKU6tdwqQqbKizkXe4davzkNG/eo4BRSXljJxtj5j75OrkitpNqyseGZlJt4nveU+c3NO",
+	"7ciyvi1k1ln2ihdGpBOafoNh7vSp1IWa3e5dsBzIPVJA4Teu9ij8Ku6G8CzqXsHweq4gX0PaEdvdrILd",
+	"AuX164Mw0ePjjuI9zryvEOJR7hUfS1kJcy3V9UbXlemg+Xdf9XH8B71jBcS1wHoIGd7qURuBWz4F2Yhj",
+	"dbjrd1/Ni8HBEr5FW8dVpssRUwWYovAdxhv9YKUrd+h5a8QFq0RZ8MwpKY0igURlVm9vjdUKv6Rvuloa",
+	"MkJgEZEWFZBcVO6U3IoLtpF5b3g04g/OWxgsBXUPJiJTkbTdkzQwomxF9CD9Lpe2p7ENKAsOgcheFTt0",
+	"Ffj96oAFiGS2YVu+b0Sz2JZ2H+hRreAcnKvn5+dz7IrgxNjUxcSProMCLSJL+Qv+hxfMveHUgCYTJgh9",
+	"XyRkR/bvW1Db0dMLzzvuJ6v9JPO3tfF9GiYFLF/9S7foQqysz5wiYLyX+nh/C60Ujlu3vAMufe6LBfb+",
+	"BdCOkMRhzMU9z+O+gam1U3i8zlr6KZI/Qpc8IpJeKBZ4jp+YUmRyJTMmYBAG30SXmQvLZWEmWJznuXT/",
+	"dS/TIUKIhNHPkgjwo0GPL9im3nL1BC4eaCUMHjf06Q07mr6Tx5VUjBx56a+PffTN3So78Rbtpr7jRS0W",
+	"xlPgu2MHsq5YwdW67oakjKyRRkod2PHF+myW46wtrcs8dzFlbYrK8s2ntI1FuFbyDqNZtBUB24zuOhuN",
+	"LPajwNPmKuRhPCSN7/537Jr2Mq7WgTu7jxsCjR40ByPPaQZ30shbch/1T/tF584CdFvNdLUGXTrAuQuA",
+	"n8T8ln98RQ+/Oo/ECA2w9IM32R1j5O9faxZcY+5hDHcPI+O0horgRiJpn+kqpyuCV+0X29kQNTR21CC8",
+	"7Gp0lszccE57oznZTeWAu0jfCeBWNzD/ewL+NMaHdHVZeva+1DnuQUBBsAjYI6lz7ZoNr6hmB+j3lKlR",
+	"SPUhShR/M5oU2+4KREGAO119kGp9oIH8+n6erx4lmiFTQlNnHaOYdmw9wDSx0zQKiIye8zqgLsNCpQux",
+	"aPO90eSLWe63Hbj35j22CE0arHwUaW903CHLbeNYJBYjRoRrcq7dzRTNMBd02bSo5/B8K5WBt1JWia2+",
+	"E/4nikPgai0YAEeWNAri4Az058KZdfBaifNs4Bu7ETAZc/PrO7qxeGOvg/B2z7Yc2Bbe39JA7lqL81/4",
+	"ufFiIKyVaj2UrbgLXLyvyv0C3A5vbsnw1wWz/ANZab1M7vh0OjdhhCZJE4SkERnRK/Ef5HpTQJZFzJos",
+	"ilmfUPP99/g2MAgsO3Yy88o2GMC7dKOswi9YI2bxqdJM+wZmix0s8Xv8lZJlKWxrkxZF3jhEdhtd+Fsc",
+	"2DTc/+CdBX5FfC9xEzdoiG2DHs6GmHKhpjgi42zj34cruzBoIQmjR26lxnnpwp2kSSF4peC/kxQn1MUy",
+	"9CM3qtXKCNsEpXvXkidgtuEVzyyaSBv6ovLQPDFnSaT8UDRkrrKjADR0qx5k+h5JCRa8nEcJ+Uct1bSV",
+	"e+LMpYAlJwOsbgXDPe6Or/VaqlFgSm7MTlcxjnNPAI4Cxjg7MvBn4vvxs6OBK7akH3n1gXy1I6tyl/WF",
+	"+kDR9zCnbMsrL8iZVsKwW7HSlUBXisF3mNV6cTLstRxbiM02Q/Bxr5pr2NPX27Frd8RLjNsdTafuGoG7",
+	"wQVbsR1XFu8/OPxh10yTRbNVwIa91jpvJ2PSpGSuPYepnnV1F13fFoHiQtcTnI8gP2i13fWMLNoN/Fku",
+	"1YS0zuLSPm2jXNGamsY8kMtzW7q+yP5K7xum3jV9H28m7QckLFd5XQzn9I2PXAw+3rPrWvCOCnRaFYLf",
+	"0b6XJvBm+MQHMvdXffv98FoocnnQmlEE0Uep8xPs+AHWqmWkmyKTabJUpu8B9F50iLh69XboIjk67twB",
+	"2ShUnvizJiu3pSY0238lFdUeg9J5HbRBkrG62k/m/S8O6QKLXjR1+dLX9AQSwysDN9pRefaNK48mnq8V",
+	"01QraGGdwM24UrdEyvlac4dLOTguFiZywcXVR24tCvNblpDSRYBPSunfdwDMtE0EWbYr3ZgvsrhRFJQM",
+	"vGybHSYHtZoafubMADzLRGkvGNyWzfCgsnq2CIy7W+ciK6TquKHdTGl7DrRnyq1AUaALn5iAceoXfpjO",
+	"AHihx+cm8Hx37+m0DpRtOICPfI/e26JEGcnlGCBuWTpHJTJZSowRDFbtHNvwIncGe3gSTLIVXO02GFYG",
+	"B6ZhW672jBuyo0C1Rbmuqyadg5bt0zk6pFtrYZhWrJAfBNhiGRpdutTKL7rANr/36Iee+Rb1zFis9iSE",
+	"ARAdEsbyQTyMLXHyaao4GTSnx/X8ie3DnkgMLiE7vIXcSXOA1X1aE1wS7NtbmI/3dYAs13YCyJeJv8Wa",
+	"xTiKpg9HF2Xqz8h2QZNiK0BD3HjpYmU8F9L7bseJXLogUseLpP6Fq2l4vBBWXByiyXZZGEZutKUplu3U",
+	"6Bqw7SbUnhbwSattdf3ni5SH0XO6hSJKm6bg0dhxgoSRhuVesfeZwey2EmS+5UHdJFbVIMMQ97bSoDxZ",
+	"rCKqlfca0QnAPzQRwlCP6YJkZCUyXeUUzuVH1Y0dF46Yiw4RdcVuJeaNbbCMIt2lPVnZbW1RfEEqv/Yy",
+	"0Gx4rnfXlTCYqH8B3N8MQNluBocMbOTtIMhSpgmxUiBqnc1bIjBdXoK1IRXQI9KbOs5bbS0sSaJ0KBAb",
+	"Wi0rquVou1RsNd+9qQvhpdbW216G+n5Hz264An4hZtCgZcH2BplFueHwwLgiUfGMcHhj4RUbXmW3FZRq",
+	"mBddftwmVN4h069wepdgebIT0uPEBoU2uwf3z3gRunRxJo71ue/AyevDct+RfWN1mJD6LcUOrRFHA8eO",
+	"5WUohKUcncLTwnFVI4c0O+QgK+oRJFt+rDuxyI0/2XdAUSdPRzbcuGn6BQm+6BwLbRNurwUWa6c7NEwS",
+	"bMS5a9BQPo36QrvnEsXwG3fsgL38gnH8F1ZTbipuRNoYRMmXBbJdrpWu0EvCjWgSqtfiI3xeiXVd8IqJ",
+	"j2UlDGgs7g3w76dMbLksUlZuMBrYpSb7ULrUZ+pJuHLV2YbpFWG5LLiFbeZn41Zc4NEDYHRKTFLIuJeD",
+	"vzEMoxzwqJYKD66dVLneee2opijBYDj41qDrAVjJ6kgt7aWTdA8/wCzqh2vxMUkTQAg8BYwkaYIogcfc",
+	"wj8NYDOnYrjr4nQHhu/wuoen1YrA3xeb5s8adml2n+xFFQxxjIGWW6z008ZdXnZgGH7QLcFIjOU3awhM",
+	"ynLhbmdME9FgW0bDN5cIwhBXJAPjtwRc0eyuHow2Hu3VwbAPFXKbmspFXWPpsgtXc7yx7zSmSN4pdJYy",
+	"AJFtdIG4uQmL0V3L/Cbt/QQS7AYFw40XaL2MtBCKKJ9BIpMvg9DlMa3QjDGbbg8Lz7RSVLbHalYJXiBX",
+	"gd46amk/rMzRQT5IB3mMuBhSmO0heWDUJEj6N3l6CH/LkCA+UkYRqeT0JXMFkeM4AGX8GiTA5PiRewWF",
+	"r/TruvVH7tfnH5+iXyK/WRavxBETNzELk5P6t6gG4FErdOU3sHLpxBLxcbxyB65QacsyXRTEwu4S1dAV",
+	"0wvAeqhXIVi/8VVbXdnUOJiuxNg1RsjKQtr9rLnHFyaBr5v1Hf71p3H2v3IxTP/m/X/z/q+V98OzIcLV",
+	"0fGjIAes2idxlBIxBowfRfjV5FEU3R9XG125UIpbqdcVLzf76EXKJ3dEizO4Ry21TSn4hwMDLsaZPQj+",
+	"uHcQy3isE6Ty+aeN58PrC42a23w/ll51XYg7UZiR6L0MmGrfhqOFuXDmjL3xeZW8KJqOh+4mTeMur7cL",
+	"Q76Gb+IuZpp5HtkSbkFFQQ4ugvjAJlNj3Orqio+2v5rX5khiRPkVH81cauhzirsYllOnYCJXL33JNWdc",
+	"iWyB+WkaHVgWPlaXPoKLty8BOGClv/3tb3978uOPT779lhGccVOIkv+oxXhp+TZZLJfGSpVZVk9Um4+j",
+	"ZyJ7LH60tLMCUk1nBrhnNhMfMNmwMH3i5x+gIUaQsATMZOOx6xMV2blnqGJ0THiwyBvnatdDtCMlc/gh",
+	"T131rV+QkiIoN4f0FYlUoWx3WYDFdvkj9C1gOd/dRXOEDw9xUT0Dy1IDA6km7aV5uhmDe8/R9qg6RrOf",
+	"tHiBgrruQ3dOTH36dl/G6IWzzpLg7b4cy/zY8LIU4Nr3OQ+I84sgxsAbo0AQaiWWdPr5pjPYtY8hiQ0a",
+	"CRmBLcLbcIIb981NY2qR9oz9WZOLTRTGZY3QSvC5ihYYKmuzibc/YLWysqAsO4o/YNL2FkGO43ANerUU",
+	"H7hcH3gYW49hSux8kFZvYueFvsfMboReFGYDh0NfAEwTk9mWrAqx5ceT1qtxWyOKO0ElW5RLXqOcGh96",
+	"Eu7gi1iYsXGviBzWZfRWIIFdaRZAj+8UhjlKoSXOTeE3emPAixX46vU6cr5pFKJoiEK3I3ygVyv4O6ik",
+	"CuOGInY5FVJWagNJt/sOsmDQ9pBAi7ibjmTCRZP7dNy0wPQ00hl7hRdX9JY3+6CJEnLmwKyQQlH/iTtR",
+	"oUVgCxyLvu2NrouckhKhagUQnrOV2DEjMg2+7VoVwhhWiZKqXXdMm60NvSPKA+nshG4jD9vjhjZO8EMb",
+	"kNqTMFGjaa/xyjAmVXDjgiePaOTyBr8+MJqfYhyIG5pLQNCi5Zjg/hOH03usxM+WCAYGeF3iDIiN5B2j",
+	"VlRbM3p9gm2qq9ywW7GRysf+wPeukqaRa4WMpmLRrYd17m0Cl6rtcoyMuSP2jLcyLyC6O4F9HsNe19U1",
+	"XVsv6GTCJ+4mO5RtlPLwTXeQShToPRkfR6+sUGjYAt0USyU1tSu0EnMTGbmVBa+8VeWiDWghqUGTYB5z",
+	"c+uRd8LRbtAEmqRYV3REEBKke3RXmKRJD6IRkbCGBVeHmXJ+LzV5TOjjL9aKc2KzTZiiNrn2eZPHEeaN",
+	"pclvE5AtyH9LF6VyhvvdlFrl3cjgUW5aGOgTi9PuA++GisEUNs46zgftPAGom8Ngp86MCXW243cPfU/q",
+	"7GGctHU5ofNNpCBuYFmRjNk1ob6zX+aYaTRgKL4L8Q4YYFKbjSvkEmjBcSM+JspEmmxWwoVp9JEXN7GO",
+	"H7vHI3/BNp4Fzo9xLdVKflxeXL4ZmKv9jmoRqOYF0ymiiDeEshIr+XFBCfqwonggV3pwNlh1LBgyuqdZ",
+	"wyizIQ/BPh8vDXQw3x5Y4zLk6kU1LjXjhdHE4YHeO2D0XSOMAgCpLPIc87cu0PkN7jE3VhiGhoqiP2iz",
+	"d4/SWYPgkhhRTil8Mapk1JA5vZfwWyQCttccWjIjxOhGwxx6PJA92sXUxQVVP7ZmOb/PD77c5qrErth3",
+	"q78tDG7sYyi2pI5UcffjOfGAnzf9CMYy6aY7AVTC1pUiGt8ErQNufOVG7zprdAavyWNEYMkrvhV2rJHh",
+	"4edwCbKyKXTtBM6z83TU14HnEumFtpLijnrzlv1Wdr4cRVP19flM0dfuAekA+ftP6ajC62PF2oYVppPR",
+	"QAd7a9DZxixu0GKTRuzE4t0AIm/u6yzstM0cVkkYV8l+1AYLo2whbcy/xvjWl9vtbIrlftWgDONB158O",
+	"OO4tj7YHBmr85hMC1XNGPyxQg7zk4cUmVEPGJUnbsX+00sqMv86FqjvJ2+08jyUAZt13n1VaRWXPV59F",
+	"9oyo939FYwpWgNEf0DIbVOPp5zeaM0bvk5Lra4XfPLnxcWFdIm18rQaxTdk/am1F7mLeDdvWxjJeloKj",
+	"04b8J5UYVlue72IwUhhhlHfQeYTklWaiHq+MNg2bHpVT9Ym2DbCV20MacoAv6eCVUEblwdP1tjkxyMRm",
+	"1nmXjxPxkcqlRYr/S99AF6u7SuUtlP6TC/e0L2zHKuZQuTf8wx94N/TJDZNQk2nfsV5Wxk/QCM7eVylr",
+	"80umzaIwvtKNNwd/DCZoVnDEBG5xfoZeTmiLXb8oL4G7sREt+8zc7B5NFLK3/INAKZGJXKhMUEG+G5Bj",
+	"N9HNhl26rxeoacPCqVivGF1gyB040n2FpesZcd02D198VcUMw5DqYMtuVRy9Gjm1h9cgD0TQu/tgGJbM",
+	"01PTRhVTlEKwlx3nowUaxuYWdoEJVVEj2jU/tKGtbKp+O9AHTHPJ101/8+BsjXOJZ4zzGGM82mXi2bEH",
+	"emuv6x3qt1KbwLEC8YwYtU29kqn75u2eVaIQdxz2rHPKej8yvEK+xqDTe/+onrQJHnLl4fV6g2cpCbzc",
+	"yyBiPboF3fNCkyawpGW8dgVvDi73o91v+ltlh33P6sZYabFmkZOf0qTdfL+YTW2RPXNKPHw/Cg1mCEpl",
+	"gtyi0NhpLBhqGOFBYAOqynqT59dBsUZXqwNFjtcQzYghdETHuNLRMpJYx9qfpZ2Ug5RZicX/AHeU3tue",
+	"SDts8NF0OVkL3x+MEHXBlHbREZhOieWy8djubAO0KtKsMMnK7y8fnCJ2GJ/UbcHoXUrC4xzdyO4T/9Y1",
+	"R4dm/1v6NfJdGGnuPnI/xWbhRbnht8LKjBcXsCRP3T63dTubOSZKE1pYkiY9cJM0CQHBvvHtTCN6Sd/f",
+	"NVVy5VrmZsrmh4cotv4Ny7xFArPaduzDmC4QhK5nHyrRrmffkuryz86XVgV7+0U1n7kSlmpfQzHnUToc",
+	"WKG6Nzl+HZ0cRPx3vVydA/tktBHPDds3etbCQOdFfW+WNriZ6pERRPgP7WL+59kcBJzHRfQ8Y/9xK9ZS",
+	"KVH9J7D0c/Yf4iOM+p8z2u4iq+TpcEKri+IEjr2wyeMoEx6WM4oqL6Zhux4RC534MRjfNhG1Dx9Zflx0",
+	"tovKu084dmzh75SYaU413UlBI/bziu/6yXZMr+7TMuodtg8d7a3zAGR5jGaw88QKoRjHy0TX6GDIWO/o",
+	"bqgvqKI0ZSfif5EtOaRQzMLd3l/viciU8azSphequgC9BELaw0kUq9i59sAumP1MtUiDqaZI+BH9L/vD",
+	"b8fbXpJykXHA2q1g0MavaitpeNzShydqiCl2TMU6v9yj8+XivjBLbxdh8OqJcy+n848DjYesMkGl2l56",
+	"4Y/SYIM4GRxsTYVLWXW0/8MSDTtqV2SDNl2WZlz5/a3pHOPhMUygBu28gu7vamG6uKseN1fq1yeidaMg",
+	"aAYq+jsxt++HeWR14Kh38VeShHvfRNmAeznGRx1QAfMLyYt90KIl49Gi005VII3IYFvtk4u//5K81PqD",
+	"FFApC0xqn35qH18BNokPw5d+SSQsIMOffOzZRQJFBt+C4aRdBC/lnwQ4gtEKvYqIxxeXr1wahy8b5XNB",
+	"2j6GVrvgbccXd5KTmg53760Q8CoJAWkL4am4Z5d+xBeXr5I0cZlNyUXy7Oz87Byoo0uheCmTi+Trs2dn",
+	"5xiNaze44KcYb4T/XQs8P0HU44H/Kk8ukh+EfUFvpEnrsUCExhizfeUpGJEv4c/kU7roZbATtx9EDIRY",
+	"EoLgxeoMw9goJFgT5Ef0Ii0N0y1g08Qsf4Mj8ac0qZyiiKj56vx8bCs27z0NotVMo2YiG9bbLQfbc/Ia",
+	"VhCLOAkjphxF4EtHnac+bKzUJkKjS22sn5ZTvmgH9t9G+JGw2MdgF1roSoLJ+/H8J0cJ97GHFzshPyEf",
+	"8wxb4atX7s3HZK+jyNuFd47AtKWDxtA+n3CYjudKcA/KWRNGqbr2FCJ/T2988QhEOEX+zixG3y194X0Z",
+	"IdoIOVgt8El4+Iwh6SW8+S5MRzgEV/7D+yy/A0F3/e0x9VOIDfwC9Nu1vBOqE2XN77gsoFC5wwMVLZxk",
+	"k5f+naOAdx8fKNcauDpQPuX5jDTz073I89PT6rfRyz3MRw12z+DI/O0ijm47NnXx8SLPGQ+V/IWIoTze",
+	"Zbh5Q+8+JnoIvME5QZD4BUdb28eW3LW2TLBt8OKhq6VQjUcVcx2A2/1yX5aa2GLtdDCN55zege/ayQ0T",
+	"snnbZSOXORhC8NRiw+ibziG29V5ZzOJwD96rXmeCwSAZV+THwe4KX51ThjnUfnHu87aRAtXV2kkjzhia",
+	"wqkuZLiC96qNqXF9HFZtPYN4wQOsOWCYtOTIi26wDse50X6v872zE1pXaiPo5/70Z5eY22qZkxe3MdP+",
+	"p+5NyFa1+BTnwpPAEYJAc49bPdv4JbrD3ZulEQmMj/Ik+pbXgthefMSUVowbi4iQp45Ky2XJG//Bv2VK",
+	"X/M0g6YpfUFAvWtSyu7Y8LtmiJh4f+quYEsp887f2B6M7Xu+gAjjv+va9qlHOFjFqeXNvucW6eL1ZRtP",
+	"ttRUH0PbLzL/9LTXWiUu3d9iu9ACVUPUiUF6YkyS8+lTUkQ7FsPiIbci01sgM0UHYEzjz1if4oy9wy+9",
+	"RWgj2Ls3rxlfc6lAyLvKbtbN68V9MD2+ukjEvsqDDjHD3XiofwptAGDfaE0ArohSKFRDc0DfFPXTw8j8",
+	"l5XgVrRrPUjkP3tAMMZ3QfsWc6lQJxD7BADjKuTH5QWPsCI78TXjxPXEoYp0CqlafWTJrnr6SyeU5lMg",
+	"psb7GCndxsFgQCDqNE3TfTLru81XnQ32wA9iYgu0/32VfwnbIZ2ftMXgyJQdFB++GR/oGDh0E5yA+X8Q",
+	"tsf5h5Q2QuXo3ZvXaCzO9U7hLpCWhD1K8VGWbw7opcfwKx+DZr4QLvzy9LI2YeuhVDJzAH+Et7/ZM/dL",
+	"ou0DHbiRMMpHPmvb5l8DqeIeud4Tx/ANfPL1/Cff6+oWyzz3b1/d9obLD+BZCfP0l7Z65Sc6RwthI77B",
+	"b/H3VsvGk/SDKG2vudjwAKUvR1ja/fsvc3oGHW8j8/UqgR4wb8wbZjJdismBpviZ0H6FY3xaZjv0fO4K",
+	"yX0WViewjyrqmHYqOTJd9cowUpJLtPEaXMqo5w6Thj17zrZS1VaYaUvXsI3w4k1xCXD8e088+jkDleqP",
+	"OWfOH/OcocqOn2X7AX6CzTcwhRhsxus6VXGGTXaBD3z3KzluHek7xntr55Vvf9ntptpUnoY9DWOwXAu8",
+	"1W1crPCs/uRc7b9W3QlQR1WXD2DocfEfCTW4r6YMEB6rKXswUlaXaM6cNRhUQXBrlNeg28HemctaDwQW",
+	"YgpcDss4i6b69TLXZEm/YzntjUc5mqZP48Yd6dAecyyNck5bczzOOC+pErCv+ks2LOuy8MKivyQX2+jR",
+	"fSlMW5xbGmasrkS+iMfe+sK/XwaLzdH2ss3naCv3nYDAr51zaVD5+SBh8kHpXVOQw+WbEDtQn7dRi+J3",
+	"kICP71CQdqeQvA8Xxc534NZUrFaA45w+cdYgrpjMz9gLV1Eaq9w2gYXeY/de3bzmxj7BgZ+8+vaGbQT3",
+	"2ZswyQ2chtc4LvTTa4sTpMhYzmNAExtgz600RuTkVE5BnGI526AwyXvV1tyBwZGVvQasV26oM/YXr/q6",
+	"Js84F2c3lTDC3vilUoUQ3+3e75WVsFBWAftf2/eKYEJcYKHfdjzaTF+fN3vJavZBiNLzNOJLK8YLeSdi",
+	"TosfhP3ubpl/otPZgohbiUzIO5Gnbcm9OD3aTAQj7FhAY4dUyf1sp5D8SFz6xNhK8G1XiPcHHGiXV/hR",
+	"QE/ak6ezoHxXVbqKzfy2W8sTe38LZWEWJxqen3/9OGAYUd0RIGZTYwM5tM2yoO0SxnZbrdmWq33AceZs",
+	"4BNHhEZkTr97JGy2K5z5yRW8RvxJ99XMnSnoniWeqo1gfxW3VxBR54Nw2ryDKaPw9+1bX0gMcAt3WxOA",
+	"Wb4e2zEWO7wfvE9mbjsNEAcGxQVYRypglo6ZDvr6gd55SMdkJ4frsX2SM2EoCNsDuCBdhhQVBuQ+fVjv",
+	"1FTQKdHr6c9aqiVE+yO89zCEg6EPJ9v5I5MNEAVnn64YL1wdApeBh6mu96YmoKGhZaMUqTtpRYdibdHa",
+	"OZq50t4P5ZaIVA19bMr52cfdni/DCponoBIWVHFlOSkchVIYiW4mhQ2HG7BDs1+cC6GxsUZMnkSzY0yb",
+	"TfLlF3tVjuTXfpGbvEY4P4998SXWfXNRoNTAl+JASSIM7I3SoAs+38pGRwORD1wx4L2nJEcWHc+v8lfu",
+	"5V8hI9KRSQv8TFoCYvmVl+sDRqQn99MS7suJTWgTsQ0ZA0/Bhku1jVe50zc+MwN+rnhlPF8eTeWg42xI",
+	"rULwbhrH8NJIpG7C4fELCuNBC8qW56IpJechh09wUXLV2FnCLpq+SsE4c7xGwL4s7ojY++hMKcTKnsTI",
+	"x+8aXX9IK0KbefqLz6+aDFl4AdvVtHSjlJi2oITvE07k5Qq9xre1DVzGY9EMnkZUdMqMJ6s9IrXSuXTu",
+	"lukik3UK6p+UQQhHQULS48v6JuvJ7U7MezpO2KdJWUfdl+S9jEgBtuFoxvTUpoKx5JbYpsPpm0hvD0RE",
+	"TNT23xz4sOFokXpyR3u7dCEYFTz+7Ep3pclfHxyx4xtB73wEWaHXczrNa3zlYciBYx9+p5qrAWCheMS9",
+	"40FHMoIRZpcd1SBR13YWi/DOEraiV5mps0wYs6oLcDAyh++m4vUZg0UyqozBpAmCuz71wNW1HTGjuf5C",
+	"U5bnH90rX3xquwN0xBAclJK1mpkdL32JDzBWpUyeibO2Kgy6s8Zqq2NxbF9B3b/p2kw6tPogzKG1ayQy",
+	"I9ay2FV1HYDRDX5D/ZMa2KKVKGVKV04p1cZL6Peq6y6N5YT+xqJlPwXO2zg34jArDdcK2WbwPkVV+Oa3",
+	"Q67363sEW16/b8eppIkb15epP1WM+dVYf5Alcb8uABFQtSr4enr/Nu9+j69++fu4C/C9UH7P83WYCICJ",
+	"h1IbBnhfk4eC11ZvOcZQN6CnvUBRvKgMiNcGa8MlaCON1dV+GTE9u7/K/+A+O1hBnA7v/IIMDm6tfqET",
+	"AZYc43YMc6j8LEoZZhc1cjNoMQyAlf3I4glG8SFLY8xwKTF66PAddqnVelHxk8u2RB34uB1YVMPuqcjl",
+	"jM7jqhdC6OkDif5ghs9kme+2GB8wpnvkTfOBUlfsoZsB6HKmeYonscPvWSyAN3I8lM3kAW3Wwl6XMrN1",
+	"NVmQx0EH/3Mvf46iPO30XVl/zz1YSPVhpPKLQxPzKOrgTirTlHKcQdwr/+qM7G3LxuZ8jwqor6jgJwMp",
+	"kDLqnkIBrDnfj4VSwCDxWmq/myqq/fVMe47jqNdDxUlJCPVQ7qTYiXyCil3qlZW849l+AfEu3ZsPKBzc",
+	"FFfOIRYXEPhK6zTzPVfyswhColjoDjCdgBhZ+0NIZRz9M0rlYxDvvaeNVAZkN3LbvXUCU/i4IO8TMuRr",
+	"05XosxS+CmX6UTaUgUzu3V/swZIVtvIi4P8HXnyYo+gxD/1cWC6L7pa+p1QEzDQBjX0ZWAmKuc3nK3q9",
+	"6b16DI/0xlhWTrCBMehxErBQymCqGracXq0KqQTZ9IO+ZG7Jxq+ZmtZM89Ub/9ZD5VLQ8If75B/ekgmf",
+	"/N9F1Y1WhcymTZ9+nYxjd/LWjoi1U5+4tvOTjBd0cxfHsV1nhHvUeBUzR+UQ0gcwXQ172z9yPEcAQTS2",
+	"HJ8Q3k5RbYy8wL4tqIYoeyxhK3IXAEpSotdl3CcfdtmMrCatk3ghKV/l5Oo93GDSb1f/SI74q2Da+6Vw",
+	"xxOye4WPO/aKsA7yktDNRzD0nt5d1LXsxsy0YKNBziRM1KYbrEAll5+E1V3GKkA6vLQVOl3t+4lSb9K0",
+	"dvaolR2r+Xr1B0skLy0sMtaZCNfz6AEFAI0v13yKzEEYKSgBe2z15wG1sSTy9D7okASLLh9LCpzs85DC",
+	"lX4+ASkQAx2f6QCpuTRLd9HLgSurNqKNMoM0n6ZMZofQa820WrCHvnWw3Hcb+TV9HvL52YemhCurS9po",
+	"lJl/8h3i+r4csEdcv5djMe0m/BeIQPmu27Hs2NgT6mDmlv2ZSkrQ5Iz3uqlQ6ElTPJZMKMRTLiWUMmmD",
+	"5kDTbLTtJfAOLhUxTlpUybLPTp3WpKdkpvRzpemFKKRVStN2R4xWJ3I9EU+cqheS5Yvw6fYx41mWeshS",
+	"VvUIXzbZgwcIuCZV8WidLEeLSdCn6ssXds2i7yvuSDXMczHAwcP3CWhn68szpS35nC336S7NyyOsU6tD",
+	"tcd37osj2cZN+HlUEDf5UANxi5pWCWt1uB7RtAT9YkT/QyWl9XufHru1wnZ/vh3qoJr2X92D3rlNPN87",
+	"9UepeYzYfBd8deQOcOkBeON+QOm5aEMQLPkQmGO6efST2HfjVU6CEgklersG1ThAjOWVLk1YGYGqOmAF",
+	"Hl/KhEo7Yw2RM/YyeBU79L9XrgRKrgXWVscKH3XZWpR84YRKsKzQsOHwUaZzwZ6dP/u6W2cEV00junoj",
+	"TW0VgPi96pQfYb76SAjXyEznz9pCIA4zZlNbg2UjRsqP/HVgsX52/myI7KudtNmmdXA0JR9YWWmrM118",
+	"OSU6Omz3l1IAdQN4a9O0qZwphZEywSlsNFJDhzMocsJWsHsByk//fwC5ho1g4h0BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
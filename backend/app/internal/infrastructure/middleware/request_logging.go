@@ -24,16 +24,26 @@ type bodyLogWriter struct {
 
 // Write captures the data written to the response body.
 func (w bodyLogWriter) Write(b []byte) (int, error) {
-	w.bodyCopy.Write(b) // Capture the body
+	if !w.streaming() {
+		w.bodyCopy.Write(b) // Capture the body
+	}
 	return w.ResponseWriter.Write(b)
 }
 
 // WriteString captures the string written to the response body.
 func (w bodyLogWriter) WriteString(s string) (int, error) {
-	w.bodyCopy.WriteString(s) // Capture the body
+	if !w.streaming() {
+		w.bodyCopy.WriteString(s) // Capture the body
+	}
 	return w.ResponseWriter.WriteString(s)
 }
 
+// streaming reports whether the response is a stream, e.g. of Server-Sent Events, which is left out of the captured body
+// since it may last for hours.
+func (w bodyLogWriter) streaming() bool {
+	return strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream")
+}
+
 // LoggerConfig allows configuration of the RequestResponseLogger.
 type LoggerConfig struct {
 	MaxLogBodySize    int
@@ -124,7 +134,9 @@ func RequestResponseLogger(config ...LoggerConfig) gin.HandlerFunc {
 
 		var responseBodyString string
 		responseBodyBytes := blw.bodyCopy.Bytes()
-		if len(responseBodyBytes) > 0 {
+		if blw.streaming() {
+			responseBodyString = "[omitted: stream]"
+		} else if len(responseBodyBytes) > 0 {
 			contentType := blw.Header().Get("Content-Type") // Get content type from response header
 			if cfg.ShouldLogBodyFunc(contentType) {
 				if len(responseBodyBytes) > cfg.MaxLogBodySize {
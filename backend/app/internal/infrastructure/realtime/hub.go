@@ -0,0 +1,312 @@
+package realtime
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	kafkalib "github.com/segmentio/kafka-go"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/infrastructure/dependencies"
+	"skilly/internal/infrastructure/gen"
+)
+
+const (
+	maxSubscriptionsPerUser = 10
+	replayBufferSize        = 100             // Events kept per user for subscribers resuming after a disconnection.
+	replayWindow            = 5 * time.Minute // How long events are kept for subscribers resuming after a disconnection.
+	replaySweepInterval     = time.Minute
+	consumerRetryInterval   = 5 * time.Second // Topics may not exist yet when the instance starts.
+)
+
+var (
+	ErrTooManySubscriptions = errors.New("too many subscriptions")
+	ErrShuttingDown         = errors.New("shutting down")
+)
+
+// Event is a real-time event as pushed to subscribers.
+type Event struct {
+	Id         string // Unique and the same on every instance, empty for ephemeral events, which aren't kept for replay.
+	Payload    []byte // RealtimeEvent as JSON.
+	ReceivedAt time.Time
+}
+
+/*
+Hub tracks the subscriptions of users to their real-time events on this instance, over WebSockets or Server-Sent Events,
+and pushes the events to them.
+Events are fanned out through Kafka, every instance reading all of them, so that they reach the subscribers wherever they
+are. The last ones of every user are kept for a while so subscribers can resume after being disconnected.
+*/
+type Hub struct {
+	deps     *dependencies.Dependencies
+	instance string // Identifies the instance in presence records.
+
+	mu      sync.Mutex
+	subs    map[string]map[*Subscription]struct{} // Subscriptions by username.
+	replay  map[string][]Event                    // Last events by username, oldest first.
+	closing bool
+	wg      sync.WaitGroup // Subscriptions being served.
+
+	presenceChanges chan presenceChange
+}
+
+func NewHub(deps *dependencies.Dependencies) *Hub {
+	id := make([]byte, 8)
+	rand.Read(id)
+
+	return &Hub{
+		deps:            deps,
+		instance:        hex.EncodeToString(id),
+		subs:            map[string]map[*Subscription]struct{}{},
+		replay:          map[string][]Event{},
+		presenceChanges: make(chan presenceChange, presenceQueueSize),
+	}
+}
+
+// Run delivers the events published by every instance to the subscribers on this one and tracks presence, until ctx is canceled.
+func (h *Hub) Run(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for _, task := range []func(context.Context){
+		func(ctx context.Context) { h.consume(ctx, events.RealtimeTopic, h.handleRealtimeEvent) },
+		func(ctx context.Context) { h.consume(ctx, events.NotificationsTopic, h.handleNotification) },
+		h.trackPresence,
+		h.sweepReplay,
+	} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			task(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// consume reads every partition of the topic from its end, handling the messages of each partition in order.
+func (h *Hub) consume(ctx context.Context, topic string, handle func(kafkalib.Message)) {
+	var consumers []*kafkalib.Reader
+	for {
+		var err error
+		consumers, err = h.deps.Kafka.NewBroadcastConsumers(ctx, topic)
+		if err == nil {
+			break
+		}
+		h.deps.Logger.Error("failed to create consumers", slog.String("topic", topic), slog.Any("error", err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(consumerRetryInterval):
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	for _, consumer := range consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer consumer.Close()
+			h.read(ctx, topic, consumer, handle)
+		}()
+	}
+	wg.Wait()
+}
+
+func (h *Hub) read(ctx context.Context, topic string, consumer *kafkalib.Reader, handle func(kafkalib.Message)) {
+	for {
+		msg, err := consumer.ReadMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			h.deps.Logger.Error("failed to read message", slog.String("topic", topic), slog.Any("error", err))
+			continue
+		}
+		handle(msg)
+	}
+}
+
+func (h *Hub) handleRealtimeEvent(msg kafkalib.Message) {
+	var event events.RealtimeEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		h.deps.Logger.Error("failed to unmarshal realtime event", slog.Any("error", err))
+		return
+	}
+	id := eventId(msg)
+	if event.Ephemeral {
+		id = ""
+	}
+	h.Deliver(event.Recipients, Event{Id: id, Payload: event.Payload})
+}
+
+func (h *Hub) handleNotification(msg kafkalib.Message) {
+	var notification events.Notification
+	if err := json.Unmarshal(msg.Value, &notification); err != nil {
+		h.deps.Logger.Error("failed to unmarshal notification", slog.Any("error", err))
+		return
+	}
+
+	payload, err := json.Marshal(gen.RealtimeEvent{
+		Type: gen.RealtimeEventTypeNotification,
+		Notification: &gen.Notification{
+			Kind:      gen.NotificationKind(notification.Kind),
+			Data:      notification.Data,
+			CreatedAt: notification.CreatedAt,
+		},
+	})
+	if err != nil {
+		h.deps.Logger.Error("failed to marshal notification event", slog.Any("error", err))
+		return
+	}
+	h.Deliver([]string{notification.Recipient}, Event{Id: eventId(msg), Payload: payload})
+}
+
+func eventId(msg kafkalib.Message) string {
+	return fmt.Sprintf("%s-%d-%d", msg.Topic, msg.Partition, msg.Offset)
+}
+
+// Deliver keeps the event for replay and pushes it to every subscription of the users, closing those not keeping up.
+func (h *Hub) Deliver(usernames []string, event Event) {
+	event.ReceivedAt = time.Now()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, username := range usernames {
+		if len(event.Id) > 0 {
+			replay := append(h.replay[username], event)
+			h.replay[username] = trimReplay(replay, event.ReceivedAt)
+		}
+
+		for sub := range h.subs[username] {
+			select {
+			case <-sub.closed:
+				continue
+			default:
+			}
+
+			select {
+			case sub.events <- event:
+			default:
+				h.deps.Logger.Warn("closing slow subscription", slog.String("username", username))
+				sub.close(CloseTooSlow, "too slow")
+			}
+		}
+	}
+}
+
+/*
+Subscribe subscribes to the user's events. Given the id of the last event the subscriber received, it also returns the
+events received since, and whether they could all be found.
+*/
+func (h *Hub) Subscribe(username string, lastEventId string) (*Subscription, []Event, bool, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closing {
+		return nil, nil, false, ErrShuttingDown
+	}
+	if len(h.subs[username]) >= maxSubscriptionsPerUser {
+		return nil, nil, false, ErrTooManySubscriptions
+	}
+
+	sub := newSubscription(username)
+	if h.subs[username] == nil {
+		h.subs[username] = map[*Subscription]struct{}{}
+		h.queuePresenceChange(username, true)
+	}
+	h.subs[username][sub] = struct{}{}
+	h.wg.Add(1)
+
+	if len(lastEventId) == 0 {
+		return sub, nil, true, nil
+	}
+	replay := h.replay[username]
+	for i, event := range replay {
+		if event.Id == lastEventId {
+			return sub, append([]Event{}, replay[i+1:]...), true, nil
+		}
+	}
+	return sub, nil, false, nil
+}
+
+// Unsubscribe closes the subscription, if it isn't already, and forgets it.
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	sub.close(CloseNormal, "")
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.subs[sub.Username], sub)
+	if len(h.subs[sub.Username]) == 0 {
+		delete(h.subs, sub.Username)
+		h.queuePresenceChange(sub.Username, false)
+	}
+	h.wg.Done()
+}
+
+// Shutdown closes every subscription and waits for them to be unsubscribed, as long as ctx allows. New ones are refused.
+func (h *Hub) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	h.closing = true
+	for _, subs := range h.subs {
+		for sub := range subs {
+			sub.close(CloseGoingAway, "server shutting down")
+		}
+	}
+	h.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sweepReplay regularly forgets the events of users who haven't received any lately.
+func (h *Hub) sweepReplay(ctx context.Context) {
+	ticker := time.NewTicker(replaySweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			h.mu.Lock()
+			for username, replay := range h.replay {
+				if replay = trimReplay(replay, now); len(replay) == 0 {
+					delete(h.replay, username)
+				} else {
+					h.replay[username] = replay
+				}
+			}
+			h.mu.Unlock()
+		}
+	}
+}
+
+// trimReplay drops the oldest events beyond the buffer size or the window.
+func trimReplay(replay []Event, now time.Time) []Event {
+	start := max(0, len(replay)-replayBufferSize)
+	for start < len(replay) && now.Sub(replay[start].ReceivedAt) > replayWindow {
+		start++
+	}
+	if start == 0 {
+		return replay
+	}
+	// copied so the dropped events can be garbage collected
+	return append([]Event(nil), replay[start:]...)
+}
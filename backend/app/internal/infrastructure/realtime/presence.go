@@ -0,0 +1,115 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"time"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+)
+
+const (
+	presenceQueueSize       = 1024
+	presenceTTL             = 3 * time.Minute // Presence records of an instance expire this long after it stops refreshing them.
+	presenceRefreshInterval = time.Minute
+)
+
+// presenceChange is a user subscribing on this instance while not subscribed yet, or unsubscribing their last subscription.
+type presenceChange struct {
+	username string
+	online   bool
+}
+
+// queuePresenceChange queues the change for trackPresence, which handles changes in order. Must be called with h.mu held.
+func (h *Hub) queuePresenceChange(username string, online bool) {
+	select {
+	case h.presenceChanges <- presenceChange{username: username, online: online}:
+	default:
+		// the record is left to expire, or kept by the next change
+		h.deps.Logger.Warn("presence change queue full, dropping change", slog.String("username", username))
+	}
+}
+
+/*
+trackPresence records the users subscribed on this instance, and lets the users they have conversations with know when
+they come online anywhere or go offline everywhere.
+*/
+func (h *Hub) trackPresence(ctx context.Context) {
+	presenceRepo := repository.NewPresenceRepository(h.deps.Mongo, h.deps.Logger)
+	ticker := time.NewTicker(presenceRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change := <-h.presenceChanges:
+			h.changePresence(ctx, presenceRepo, change)
+		case <-ticker.C:
+			h.mu.Lock()
+			usernames := make([]string, 0, len(h.subs))
+			for username := range h.subs {
+				usernames = append(usernames, username)
+			}
+			h.mu.Unlock()
+
+			err := presenceRepo.RefreshConnected(ctx, usernames, h.instance, time.Now().Add(presenceTTL))
+			if err != nil {
+				h.deps.Logger.Error("failed to refresh presence", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+func (h *Hub) changePresence(ctx context.Context, presenceRepo repository.PresenceRepository, change presenceChange) {
+	var changed bool
+	var err error
+	if change.online {
+		changed, err = presenceRepo.MarkConnected(ctx, change.username, h.instance, time.Now().Add(presenceTTL))
+	} else {
+		changed, err = presenceRepo.MarkDisconnected(ctx, change.username, h.instance)
+	}
+	if err != nil || !changed {
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(h.deps.Mongo, h.deps.Logger)
+	partners, err := conversationRepo.GetConversationPartners(ctx, change.username)
+	if err != nil {
+		return
+	}
+	blockRepo := repository.NewBlockRepository(h.deps.Mongo, h.deps.Logger)
+	blocked, err := blockRepo.GetBlockedUsernames(ctx, change.username)
+	if err != nil {
+		return
+	}
+	partners = slices.DeleteFunc(partners, func(username string) bool {
+		return slices.Contains(blocked, username)
+	})
+	if len(partners) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(gen.RealtimeEvent{
+		Type:     gen.RealtimeEventTypePresence,
+		Presence: &gen.Presence{Username: change.username, Online: change.online},
+	})
+	if err != nil {
+		h.deps.Logger.Error("failed to marshal presence event", slog.Any("error", err))
+		return
+	}
+	value, err := json.Marshal(events.RealtimeEvent{Recipients: partners, Payload: payload})
+	if err != nil {
+		h.deps.Logger.Error("failed to marshal realtime event", slog.Any("error", err))
+		return
+	}
+
+	err = h.deps.Kafka.ProduceMessage(ctx, events.RealtimeTopic, []byte(change.username), value)
+	if err != nil {
+		h.deps.Logger.Error("failed to publish presence event", slog.Any("error", err))
+	}
+}
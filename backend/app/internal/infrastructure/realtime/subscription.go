@@ -0,0 +1,59 @@
+package realtime
+
+import (
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	subscriptionBufferSize = 64 // Events queued for a subscriber before it is considered too slow.
+)
+
+// Reasons subscriptions are closed for, as WebSocket close codes.
+const (
+	CloseNormal    = websocket.CloseNormalClosure
+	CloseGoingAway = websocket.CloseGoingAway
+	CloseTooSlow   = websocket.CloseTryAgainLater
+)
+
+// Subscription is a subscriber's feed of a user's events, until it is closed.
+type Subscription struct {
+	Username string
+	events   chan Event
+
+	closeOnce sync.Once
+	closed    chan struct{}
+	closeCode int
+	closeText string
+}
+
+func newSubscription(username string) *Subscription {
+	return &Subscription{
+		Username: username,
+		events:   make(chan Event, subscriptionBufferSize),
+		closed:   make(chan struct{}),
+	}
+}
+
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Closed is closed once the subscription is, after which the subscriber must stop and unsubscribe.
+func (s *Subscription) Closed() <-chan struct{} {
+	return s.closed
+}
+
+// CloseReason returns why the subscription was closed, once it is.
+func (s *Subscription) CloseReason() (int, string) {
+	return s.closeCode, s.closeText
+}
+
+func (s *Subscription) close(code int, text string) {
+	s.closeOnce.Do(func() {
+		s.closeCode = code
+		s.closeText = text
+		close(s.closed)
+	})
+}
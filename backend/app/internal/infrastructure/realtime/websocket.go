@@ -0,0 +1,84 @@
+package realtime
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	writeWait   = 10 * time.Second // Time allowed to write a frame to the client.
+	pongWait    = time.Minute      // Time allowed to read the next pong from the client.
+	pingPeriod  = 30 * time.Second // Must be less than pongWait.
+	maxReadSize = 512              // Clients only send control frames.
+)
+
+// ServeWebSocket pushes the user's events to the connection until it is closed, by either side, for failing heartbeats or backpressure.
+func (h *Hub) ServeWebSocket(ws *websocket.Conn, username string) {
+	sub, _, _, err := h.Subscribe(username, "")
+	if err != nil {
+		code := CloseGoingAway
+		if errors.Is(err, ErrTooManySubscriptions) {
+			code = websocket.ClosePolicyViolation
+		}
+		ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, err.Error()), time.Now().Add(writeWait))
+		ws.Close()
+		return
+	}
+	defer h.Unsubscribe(sub)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		writePump(ws, sub)
+	}()
+	readPump(ws, sub)
+	<-done
+}
+
+// readPump handles heartbeats and the client closing the connection, discarding anything else the client sends.
+func readPump(ws *websocket.Conn, sub *Subscription) {
+	defer sub.close(CloseNormal, "")
+
+	ws.SetReadLimit(maxReadSize)
+	ws.SetReadDeadline(time.Now().Add(pongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		if _, _, err := ws.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+// writePump writes events and pings to the client until the subscription is closed, then closes the connection.
+func writePump(ws *websocket.Conn, sub *Subscription) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+	defer ws.Close()
+
+	for {
+		select {
+		case event := <-sub.Events():
+			ws.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := ws.WriteMessage(websocket.TextMessage, event.Payload); err != nil {
+				sub.close(websocket.CloseAbnormalClosure, "")
+				return
+			}
+		case <-ticker.C:
+			ws.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := ws.WriteMessage(websocket.PingMessage, nil); err != nil {
+				sub.close(websocket.CloseAbnormalClosure, "")
+				return
+			}
+		case <-sub.Closed():
+			// the client may be gone already, in which case the close frame can't be sent
+			code, text := sub.CloseReason()
+			ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(writeWait))
+			return
+		}
+	}
+}
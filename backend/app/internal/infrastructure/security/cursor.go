@@ -0,0 +1,56 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// getCursorSecretKey returns the key cursors are signed with, falling back to the JWT one.
+func getCursorSecretKey() string {
+	if secret := os.Getenv("CURSOR_SECRET_KEY"); secret != "" {
+		return secret
+	}
+	return getSecretKey()
+}
+
+/*
+SignCursor turns the payload into an opaque pagination cursor.
+Cursors aren't encrypted, only signed, so clients can't forge or tamper with them.
+*/
+func SignCursor(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(getCursorSecretKey()))
+	mac.Write(payload)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyCursor returns the payload of a cursor created by SignCursor.
+func VerifyCursor(cursor string) ([]byte, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	mac := hmac.New(sha256.New, []byte(getCursorSecretKey()))
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrInvalidCursor
+	}
+
+	return payload, nil
+}
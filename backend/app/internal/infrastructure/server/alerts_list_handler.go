@@ -0,0 +1,38 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetAlerts(c *gin.Context, params gen.GetAlertsParams) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	savedSearchRepo := repository.NewSavedSearchRepository(s.deps.Mongo, s.deps.Logger)
+	alerts, err := savedSearchRepo.ListAlerts(c.Request.Context(), owner, *params.Unread, int64(*params.Page), int64(*params.Pagesize))
+	if err != nil {
+		s.deps.Logger.Error("failed to list search alerts", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	result := make([]gen.SearchAlert, len(alerts))
+	for i, alert := range alerts {
+		result[i] = newSearchAlert(alert)
+	}
+
+	c.JSON(http.StatusOK, gen.SearchAlertsResponse{
+		Alerts: result,
+	})
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostAlertsRead(c *gin.Context) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	savedSearchRepo := repository.NewSavedSearchRepository(s.deps.Mongo, s.deps.Logger)
+	err = savedSearchRepo.MarkAlertsRead(c.Request.Context(), owner)
+	if err != nil {
+		s.deps.Logger.Error("failed to mark search alerts read", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
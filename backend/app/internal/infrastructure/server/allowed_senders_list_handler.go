@@ -0,0 +1,39 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetAllowedSenders(c *gin.Context, params gen.GetAllowedSendersParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	allowedSenderRepo := repository.NewAllowedSenderRepository(s.deps.Mongo, s.deps.Logger)
+	allowed, err := allowedSenderRepo.ListAllowed(c.Request.Context(), username, int64(*params.Page), int64(*params.Pagesize))
+	if err != nil {
+		s.deps.Logger.Error("failed to list allowed senders", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	users := make([]gen.AllowedSender, len(allowed))
+	for i, sender := range allowed {
+		users[i] = gen.AllowedSender{
+			Username:  sender.Username,
+			AllowedAt: sender.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, gen.AllowedSendersResponse{Users: users})
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostConversationsIdAttachments(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.CreateAttachmentRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, err := s.findConversation(c, username, id)
+	if err != nil {
+		return
+	}
+
+	attachmentRepo := repository.NewAttachmentRepository(s.deps.Mongo, s.deps.Logger)
+	attachment, err := attachmentRepo.CreateAttachment(c.Request.Context(), models.Attachment{
+		ConversationId: conversation.Id,
+		Uploader:       username,
+		Filename:       body.Filename,
+	})
+	if err != nil {
+		s.deps.Logger.Error("failed to create attachment", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	url, err := s.deps.S3.GenerateUploadUrl(c.Request.Context(), attachment.Key(), usecases.AttachmentUploadWindow)
+	if err != nil {
+		s.deps.Logger.Error("failed to generate upload url", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gen.CreateAttachmentResponse{
+		Attachment: newAttachment(*attachment),
+		UploadUrl:  url.String(),
+	})
+}
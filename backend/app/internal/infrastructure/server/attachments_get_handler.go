@@ -0,0 +1,101 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetConversationsIdAttachmentsAttachmentId(c *gin.Context, id string, attachmentId string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, err := s.findConversation(c, username, id)
+	if err != nil {
+		return
+	}
+
+	objectId, err := primitive.ObjectIDFromHex(attachmentId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "attachment_not_found",
+		})
+		return
+	}
+
+	attachmentRepo := repository.NewAttachmentRepository(s.deps.Mongo, s.deps.Logger)
+	attachment, err := attachmentRepo.GetAttachment(c.Request.Context(), conversation.Id, objectId)
+	if err != nil && !errors.Is(err, repository.ErrAttachmentNotFound) {
+		s.deps.Logger.Error("failed to get attachment", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	// attachments are private to their uploader until sent
+	if attachment == nil || (attachment.MessageId == nil && attachment.Uploader != username) {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "attachment_not_found",
+		})
+		return
+	}
+
+	// attachments of messages deleted for everyone are only kept for moderators, and those of messages hidden from the
+	// caller are hidden along with them
+	if attachment.MessageId != nil {
+		conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+		message, err := conversationRepo.GetMessage(c.Request.Context(), conversation.Id, *attachment.MessageId)
+		if err != nil && !errors.Is(err, repository.ErrMessageNotFound) {
+			s.deps.Logger.Error("failed to get message", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		if message == nil || message.DeletedAt != nil || message.HiddenFrom(username) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "attachment_not_found",
+			})
+			return
+		}
+	}
+
+	response := gen.AttachmentResponse{Attachment: newAttachment(*attachment)}
+	if attachment.Status == models.AttachmentStatusReady {
+		url, err := s.deps.S3.GenerateDownloadUrl(c.Request.Context(), attachment.Key(), time.Minute*15)
+		if err != nil {
+			s.deps.Logger.Error("failed to generate download url", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		downloadUrl := url.String()
+		response.Url = &downloadUrl
+	}
+	if attachment.Status == models.AttachmentStatusReady && attachment.HasPreview {
+		url, err := s.deps.S3.GenerateDownloadUrl(c.Request.Context(), attachment.PreviewKey(), time.Minute*15)
+		if err != nil {
+			s.deps.Logger.Error("failed to generate download url", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		previewUrl := url.String()
+		response.PreviewUrl = &previewUrl
+	}
+
+	c.JSON(http.StatusOK, response)
+}
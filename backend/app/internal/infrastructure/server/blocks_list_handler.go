@@ -0,0 +1,39 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetBlocks(c *gin.Context, params gen.GetBlocksParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	blocks, err := blockRepo.ListBlocked(c.Request.Context(), username, int64(*params.Page), int64(*params.Pagesize))
+	if err != nil {
+		s.deps.Logger.Error("failed to list blocked users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	users := make([]gen.BlockedUser, len(blocks))
+	for i, block := range blocks {
+		users[i] = gen.BlockedUser{
+			Username:  block.Blocked,
+			BlockedAt: block.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, gen.BlockedUsersResponse{Users: users})
+}
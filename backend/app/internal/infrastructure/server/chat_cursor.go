@@ -0,0 +1,68 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/security"
+)
+
+// chatCursor is the payload of the opaque cursors handed out when listing conversations, messages and circles.
+type chatCursor struct {
+	Scope    string                  `bson:"scope"` // What is listed, so the cursor can't be used to list something else.
+	Position repository.ChatPosition `bson:"position"`
+}
+
+func conversationsCursorScope(username string) string {
+	return "conversations:" + username
+}
+
+func messageRequestsCursorScope(username string) string {
+	return "requests:" + username
+}
+
+func messagesCursorScope(conversationId string) string {
+	return "messages:" + conversationId
+}
+
+// circlesCursorScope also covers the skills searched, so a cursor can't continue a search for other ones.
+func circlesCursorScope(username string, skills []string) string {
+	return fmt.Sprintf("circles:%s:%q", username, skills)
+}
+
+// messageSearchCursorScope covers the parameters of the search, so a cursor can't continue another one.
+func messageSearchCursorScope(query repository.MessageSearchQuery, conversationId string) string {
+	return fmt.Sprintf("message-search:%s:%q|%q|%q|%s|%s", query.Viewer, query.Text, conversationId, query.Sender, formatOptionalTime(query.Since), formatOptionalTime(query.Until))
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func encodeChatCursor(cursor chatCursor) (string, error) {
+	payload, err := bson.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return security.SignCursor(payload), nil
+}
+
+// decodeChatCursor decodes the cursor, failing if it was handed out for another scope.
+func decodeChatCursor(encoded string, scope string) (*repository.ChatPosition, error) {
+	payload, err := security.VerifyCursor(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor chatCursor
+	if err := bson.Unmarshal(payload, &cursor); err != nil || cursor.Scope != scope {
+		return nil, security.ErrInvalidCursor
+	}
+	return &cursor.Position, nil
+}
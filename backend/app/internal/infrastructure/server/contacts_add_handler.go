@@ -0,0 +1,71 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostContactsAdd(c *gin.Context, params gen.PostContactsAddParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	if params.Username == username {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "cannot_add_self",
+		})
+		return
+	}
+
+	repo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	_, err = repo.GetUserByUsername(c.Request.Context(), params.Username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "username_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	canInteract, err := usecases.CanInteract(c.Request.Context(), blockRepo, username, params.Username)
+	if err != nil {
+		s.deps.Logger.Error("failed to check blocks", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	if !canInteract {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "username_not_found",
+		})
+		return
+	}
+
+	err = repo.AddContact(c.Request.Context(), username, params.Username)
+	if err != nil {
+		s.deps.Logger.Error("failed to add contact", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
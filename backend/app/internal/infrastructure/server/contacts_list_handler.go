@@ -0,0 +1,38 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetContacts(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	repo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	user, err := repo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	contacts := user.Contacts
+	if contacts == nil {
+		contacts = []string{}
+	}
+
+	c.JSON(http.StatusOK, gen.ContactsResponse{
+		Usernames: contacts,
+	})
+}
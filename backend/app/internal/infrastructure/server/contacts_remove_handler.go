@@ -0,0 +1,31 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostContactsRemove(c *gin.Context, params gen.PostContactsRemoveParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	repo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	err = repo.RemoveContact(c.Request.Context(), username, params.Username)
+	if err != nil {
+		s.deps.Logger.Error("failed to remove contact", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
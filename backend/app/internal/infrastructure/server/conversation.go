@@ -0,0 +1,213 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+)
+
+/*
+newConversation converts the conversation as seen by viewer. Direct conversations come with the other user, whose
+restricted profile only shows their username, and groups with their settings and members instead.
+*/
+func newConversation(viewer string, conversation models.Conversation, other *models.User) gen.Conversation {
+	result := gen.Conversation{
+		Id:          conversation.Id.Hex(),
+		Kind:        gen.ConversationKind(conversation.Kind),
+		UnreadCount: int(conversation.UnreadCount(viewer)),
+		Reads:       []gen.ReadReceipt{},
+		CreatedAt:   conversation.CreatedAt,
+		UpdatedAt:   conversation.UpdatedAt,
+	}
+	if other != nil {
+		profile := newRestrictedUserProfile(other.Username)
+		if usecases.CanAccess(*other, viewer, other.Privacy.ProfileVisibility) {
+			profile = newUserProfile(viewer, *other)
+		}
+		result.User = &profile
+	}
+	if conversation.Group != nil {
+		group := newGroup(*conversation.Group)
+		result.Group = &group
+	}
+	if conversation.Request != nil {
+		request := newMessageRequest(viewer, *conversation.Request)
+		result.Request = &request
+	}
+	if conversation.LastMessage != nil && !conversation.LastMessage.HiddenFrom(viewer) {
+		message := newMessage(*conversation.LastMessage)
+		result.LastMessage = &message
+	}
+	for _, read := range conversation.Reads {
+		// the sender of a request isn't told whether it was read
+		if read.Seq > 0 && (conversation.Accepted() || read.Username == viewer) {
+			result.Reads = append(result.Reads, newReadReceipt(conversation.Id, read))
+		}
+	}
+	return result
+}
+
+func newGroup(group models.Group) gen.Group {
+	members := make([]gen.GroupMember, len(group.Members))
+	for i, member := range group.Members {
+		members[i] = gen.GroupMember{
+			Username: member.Username,
+			Role:     gen.GroupRole(member.Role),
+			JoinedAt: member.JoinedAt,
+		}
+	}
+	return gen.Group{
+		Name:        group.Name,
+		Skill:       group.Skill,
+		Description: group.Description,
+		Public:      group.Public,
+		MemberLimit: group.MemberLimit,
+		Members:     members,
+	}
+}
+
+func newReadReceipt(conversationId primitive.ObjectID, read models.ReadCursor) gen.ReadReceipt {
+	return gen.ReadReceipt{
+		ConversationId: conversationId.Hex(),
+		Username:       read.Username,
+		MessageId:      read.MessageId.Hex(),
+		ReadAt:         read.At,
+	}
+}
+
+func newMessage(message models.Message) gen.Message {
+	result := gen.Message{
+		Id:             message.Id.Hex(),
+		ConversationId: message.ConversationId.Hex(),
+		Sender:         message.Sender,
+		Text:           message.Text,
+		Deleted:        message.DeletedAt != nil,
+		EditedAt:       message.EditedAt,
+		CreatedAt:      message.CreatedAt,
+	}
+	if len(message.Attachments) > 0 {
+		attachments := make([]gen.Attachment, len(message.Attachments))
+		for i, attachment := range message.Attachments {
+			attachments[i] = newAttachment(attachment)
+		}
+		result.Attachments = &attachments
+	}
+	return result
+}
+
+func newAttachment(attachment models.Attachment) gen.Attachment {
+	result := gen.Attachment{
+		Id:             attachment.Id.Hex(),
+		ConversationId: attachment.ConversationId.Hex(),
+		Uploader:       attachment.Uploader,
+		Filename:       attachment.Filename,
+		Status:         gen.AttachmentStatus(attachment.Status),
+		HasPreview:     attachment.HasPreview,
+		CreatedAt:      attachment.CreatedAt,
+	}
+	if attachment.Status != models.AttachmentStatusPending {
+		size := int(attachment.Size)
+		result.ContentType = &attachment.ContentType
+		result.Size = &size
+	}
+	return result
+}
+
+/*
+findConversation gets the conversation with the id if the user takes part in it and, for direct conversations, neither
+participant has blocked the other, otherwise it responds with the error itself.
+*/
+func (s *Server) findConversation(c *gin.Context, username string, id string) (*models.Conversation, error) {
+	conversationId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "conversation_not_found",
+		})
+		return nil, err
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	conversation, err := conversationRepo.GetConversation(c.Request.Context(), conversationId, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrConversationNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "conversation_not_found",
+			})
+			return nil, err
+		}
+		s.deps.Logger.Error("failed to get conversation", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return nil, err
+	}
+
+	if conversation.Kind == models.ConversationKindGroup {
+		return conversation, nil
+	}
+
+	// conversations with blocked users are hidden, as the users themselves are
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	canInteract, err := usecases.CanInteract(c.Request.Context(), blockRepo, username, conversation.Other(username))
+	if err != nil {
+		s.deps.Logger.Error("failed to check blocks", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return nil, err
+	}
+	if !canInteract {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "conversation_not_found",
+		})
+		return nil, repository.ErrConversationNotFound
+	}
+
+	return conversation, nil
+}
+
+/*
+findMessage gets the message with the id if it belongs to the conversation and the user hasn't deleted it for
+themselves, otherwise it responds with the error itself.
+*/
+func (s *Server) findMessage(c *gin.Context, username string, conversation models.Conversation, id string) (*models.Message, error) {
+	messageId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "message_not_found",
+		})
+		return nil, err
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	message, err := conversationRepo.GetMessage(c.Request.Context(), conversation.Id, messageId)
+	if err != nil {
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "message_not_found",
+			})
+			return nil, err
+		}
+		s.deps.Logger.Error("failed to get message", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return nil, err
+	}
+	if message.HiddenFrom(username) {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "message_not_found",
+		})
+		return nil, repository.ErrMessageNotFound
+	}
+
+	return message, nil
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetConversations(c *gin.Context, params gen.GetConversationsParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	scope := conversationsCursorScope(username)
+	var after *repository.ChatPosition
+	if params.Cursor != nil {
+		after, err = decodeChatCursor(*params.Cursor, scope)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "invalid_cursor",
+			})
+			return
+		}
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	blocked, err := blockRepo.GetBlockedUsernames(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get blocked users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	page, err := conversationRepo.ListConversations(c.Request.Context(), username, blocked, after, int64(*params.Pagesize))
+	if err != nil {
+		s.deps.Logger.Error("failed to list conversations", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	usernames := make([]string, 0, len(page.Conversations))
+	for _, conversation := range page.Conversations {
+		if conversation.Kind == models.ConversationKindDirect {
+			usernames = append(usernames, conversation.Other(username))
+		}
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	users, err := userRepo.GetUsersByUsernames(c.Request.Context(), usernames)
+	if err != nil {
+		s.deps.Logger.Error("failed to get users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	usersByUsername := make(map[string]models.User, len(users))
+	for _, user := range users {
+		usersByUsername[user.Username] = user
+	}
+
+	// direct conversations with deleted users are skipped
+	response := gen.ConversationsResponse{Conversations: make([]gen.Conversation, 0, len(page.Conversations))}
+	for _, conversation := range page.Conversations {
+		if conversation.Kind == models.ConversationKindGroup {
+			response.Conversations = append(response.Conversations, newConversation(username, conversation, nil))
+			continue
+		}
+		other, ok := usersByUsername[conversation.Other(username)]
+		if !ok {
+			continue
+		}
+		response.Conversations = append(response.Conversations, newConversation(username, conversation, &other))
+	}
+
+	if page.HasMore {
+		nextCursor, err := encodeChatCursor(chatCursor{Scope: scope, Position: page.Next})
+		if err != nil {
+			s.deps.Logger.Error("failed to encode cursor", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		response.NextCursor = &nextCursor
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostConversationsIdRead(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.MarkReadRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, err := s.findConversation(c, username, id)
+	if err != nil {
+		return
+	}
+
+	messageId, err := primitive.ObjectIDFromHex(body.MessageId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "message_not_found",
+		})
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	message, err := conversationRepo.GetMessage(c.Request.Context(), conversation.Id, messageId)
+	if err != nil {
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "message_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get message", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	// the cursor only moves past messages the reader can see
+	if message.HiddenFrom(username) {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "message_not_found",
+		})
+		return
+	}
+
+	read, err := conversationRepo.MarkRead(c.Request.Context(), *message, username)
+	if err != nil {
+		s.deps.Logger.Error("failed to mark messages as read", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	// the reader's other connections hear about it too, to update their unread counts, while the sender of a request
+	// isn't told whether it was read
+	if read != nil {
+		recipients := conversation.Participants
+		if !conversation.Accepted() {
+			recipients = []string{username}
+		}
+		receipt := newReadReceipt(conversation.Id, *read)
+		s.publishRealtimeEvent(conversation.Id.Hex(), recipients, gen.RealtimeEvent{
+			Type: gen.RealtimeEventTypeRead,
+			Read: &receipt,
+		})
+	}
+
+	c.Status(http.StatusNoContent)
+}
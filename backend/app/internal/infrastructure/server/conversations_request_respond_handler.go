@@ -0,0 +1,72 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostConversationsIdRequest(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.RespondMessageRequestRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, err := s.findConversation(c, username, id)
+	if err != nil {
+		return
+	}
+	if conversation.Accepted() || conversation.Request.To != username {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "message_request_not_found",
+		})
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	if body.Action == gen.MessageRequestActionAccept {
+		err = s.acceptMessageRequest(c.Request.Context(), conversation)
+	} else {
+		err = conversationRepo.SetMessageRequestStatus(c.Request.Context(), conversation.Id, username, models.MessageRequestDeclined)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrRequestNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "message_request_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to answer message request", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	if body.Action == gen.MessageRequestActionBlock {
+		userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+		blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+		if err := usecases.BlockUser(c.Request.Context(), userRepo, blockRepo, username, conversation.Request.From); err != nil {
+			s.deps.Logger.Error("failed to block user", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostConversations(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.StartConversationRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	if body.Username == username {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "cannot_message_self",
+		})
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	other, err := userRepo.GetUserByUsername(c.Request.Context(), body.Username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "username_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	canInteract, err := usecases.CanInteract(c.Request.Context(), blockRepo, username, other.Username)
+	if err != nil {
+		s.deps.Logger.Error("failed to check blocks", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	if !canInteract {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "username_not_found",
+		})
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	conversation, err := conversationRepo.FindDirectConversation(c.Request.Context(), username, other.Username)
+	if errors.Is(err, repository.ErrConversationNotFound) {
+		allowedSenderRepo := repository.NewAllowedSenderRepository(s.deps.Mongo, s.deps.Logger)
+		var request *models.MessageRequest
+		request, err = usecases.NewMessageRequest(c.Request.Context(), allowedSenderRepo, conversationRepo, username, other.Username, messageRequestDailyLimit())
+		if errors.Is(err, usecases.ErrTooManyMessageRequests) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "too_many_message_requests",
+			})
+			return
+		}
+		if err == nil {
+			conversation, err = conversationRepo.GetOrCreateConversation(c.Request.Context(), username, other.Username, request)
+		}
+	}
+	if err != nil {
+		s.deps.Logger.Error("failed to start conversation", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	// starting a conversation with whoever sent a request accepts it
+	if !conversation.Accepted() && conversation.Request.To == username {
+		if err := s.acceptMessageRequest(c.Request.Context(), conversation); err != nil {
+			s.deps.Logger.Error("failed to accept message request", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, newConversation(username, *conversation, other))
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostConversationsIdTyping(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, err := s.findConversation(c, username, id)
+	if err != nil {
+		return
+	}
+
+	others := slices.DeleteFunc(slices.Clone(conversation.Listeners()), func(participant string) bool {
+		return participant == username
+	})
+	go s.publishEphemeralEvent(conversation.Id.Hex(), others, gen.RealtimeEvent{
+		Type: gen.RealtimeEventTypeTyping,
+		Typing: &gen.Typing{
+			ConversationId: conversation.Id.Hex(),
+			Username:       username,
+		},
+	})
+
+	c.Status(http.StatusNoContent)
+}
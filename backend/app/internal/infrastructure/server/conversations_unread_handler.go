@@ -0,0 +1,88 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetConversationsUnread(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	blocked, err := blockRepo.GetBlockedUsernames(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get blocked users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	counts, err := conversationRepo.GetUnreadCounts(c.Request.Context(), username, blocked)
+	if err != nil {
+		s.deps.Logger.Error("failed to count unread messages", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	usernames := make([]string, 0, len(counts))
+	for _, count := range counts {
+		if count.Kind == models.ConversationKindGroup {
+			continue
+		}
+		for _, participant := range count.Participants {
+			if participant != username {
+				usernames = append(usernames, participant)
+			}
+		}
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	users, err := userRepo.GetUsersByUsernames(c.Request.Context(), usernames)
+	if err != nil {
+		s.deps.Logger.Error("failed to get users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	existing := make(map[string]bool, len(users))
+	for _, user := range users {
+		existing[user.Username] = true
+	}
+
+	// direct conversations with deleted users are skipped, as they are when listed
+	response := gen.UnreadResponse{Conversations: make([]gen.UnreadCount, 0, len(counts))}
+	for _, count := range counts {
+		deleted := false
+		if count.Kind == models.ConversationKindDirect {
+			for _, participant := range count.Participants {
+				deleted = deleted || (participant != username && !existing[participant])
+			}
+		}
+		if deleted {
+			continue
+		}
+		response.Total += int(count.Count)
+		response.Conversations = append(response.Conversations, gen.UnreadCount{
+			ConversationId: count.ConversationId.Hex(),
+			UnreadCount:    int(count.Count),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/infrastructure/gen"
+)
+
+const (
+	publishEventTimeout = 10 * time.Second
+)
+
+// publishEvent publishes the event to the topic. Failures are only logged, events are best effort.
+func (s *Server) publishEvent(topic string, key string, event any) {
+	value, err := json.Marshal(event)
+	if err != nil {
+		s.deps.Logger.Error("failed to marshal event", slog.String("topic", topic), slog.Any("error", err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishEventTimeout)
+	defer cancel()
+
+	err = s.deps.Kafka.ProduceMessage(ctx, topic, []byte(key), value)
+	if err != nil {
+		s.deps.Logger.Error("failed to publish event", slog.String("topic", topic), slog.Any("error", err))
+	}
+}
+
+/*
+publishRealtimeEvent publishes the event to be pushed to the recipients' connections, on whichever instance they are.
+It returns once the event is written, so handlers calling it before responding publish the events of a conversation,
+their key, in the order the requests were made: a message is never deleted or edited on screen before it shows up.
+*/
+func (s *Server) publishRealtimeEvent(key string, recipients []string, event gen.RealtimeEvent) {
+	s.publishRealtime(key, recipients, event, false)
+}
+
+// publishEphemeralEvent publishes the event like publishRealtimeEvent, but only for the connections open at the time.
+func (s *Server) publishEphemeralEvent(key string, recipients []string, event gen.RealtimeEvent) {
+	s.publishRealtime(key, recipients, event, true)
+}
+
+func (s *Server) publishRealtime(key string, recipients []string, event gen.RealtimeEvent, ephemeral bool) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		s.deps.Logger.Error("failed to marshal realtime event", slog.Any("error", err))
+		return
+	}
+
+	s.publishEvent(events.RealtimeTopic, key, events.RealtimeEvent{
+		Recipients: recipients,
+		Payload:    payload,
+		Ephemeral:  ephemeral,
+	})
+}
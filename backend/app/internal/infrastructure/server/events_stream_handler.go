@@ -0,0 +1,91 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/realtime"
+	"skilly/internal/infrastructure/security"
+)
+
+const (
+	eventStreamHeartbeatPeriod = 30 * time.Second
+	eventStreamRetry           = 3 * time.Second // How long clients wait before reconnecting.
+)
+
+func (s *Server) GetEvents(c *gin.Context, params gen.GetEventsParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	// browsers set the header when reconnecting by themselves, the parameter is for clients opening a new stream
+	lastEventId := c.GetHeader("Last-Event-ID")
+	if len(lastEventId) == 0 && params.LastEventId != nil {
+		lastEventId = *params.LastEventId
+	}
+
+	sub, missed, resumed, err := s.hub.Subscribe(username, lastEventId)
+	if err != nil {
+		code := "shutting_down"
+		if errors.Is(err, realtime.ErrTooManySubscriptions) {
+			code = "too_many_connections"
+		}
+		c.JSON(http.StatusServiceUnavailable, gen.Error{
+			Code: code,
+		})
+		return
+	}
+	defer s.hub.Unsubscribe(sub)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no") // Keeps nginx from buffering the stream.
+	c.Status(http.StatusOK)
+
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", eventStreamRetry.Milliseconds())
+	if !resumed {
+		// the events missed are gone, the client has to fetch what it missed
+		fmt.Fprint(c.Writer, "event: reset\ndata: {}\n\n")
+	}
+	for _, event := range missed {
+		writeStreamEvent(c.Writer, event)
+	}
+	c.Writer.Flush()
+
+	ticker := time.NewTicker(eventStreamHeartbeatPeriod)
+	defer ticker.Stop()
+
+	for {
+		var err error
+		select {
+		case event := <-sub.Events():
+			err = writeStreamEvent(c.Writer, event)
+		case <-ticker.C:
+			_, err = fmt.Fprint(c.Writer, ": heartbeat\n\n")
+		case <-sub.Closed():
+			return
+		case <-c.Request.Context().Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+		c.Writer.Flush()
+	}
+}
+
+// writeStreamEvent writes the event, without an id if it is ephemeral so that the client's last event id stays one it can resume from.
+func writeStreamEvent(w gin.ResponseWriter, event realtime.Event) error {
+	if len(event.Id) == 0 {
+		_, err := fmt.Fprintf(w, "data: %s\n\n", event.Payload)
+		return err
+	}
+	_, err := fmt.Fprintf(w, "id: %s\ndata: %s\n\n", event.Id, event.Payload)
+	return err
+}
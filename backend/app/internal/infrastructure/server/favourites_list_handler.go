@@ -0,0 +1,95 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetFavourites(c *gin.Context, params gen.GetFavouritesParams) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	tag := ""
+	if params.Tag != nil {
+		tag = *params.Tag
+	}
+
+	favouriteRepo := repository.NewFavouriteRepository(s.deps.Mongo, s.deps.Logger)
+	favourites, err := favouriteRepo.ListFavourites(c.Request.Context(), owner, tag, int64(*params.Page), int64(*params.Pagesize))
+	if err != nil {
+		s.deps.Logger.Error("failed to list favourites", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	usernames := make([]string, len(favourites))
+	for i, favourite := range favourites {
+		usernames[i] = favourite.Username
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	users, err := userRepo.GetUsersByUsernames(c.Request.Context(), usernames)
+	if err != nil {
+		s.deps.Logger.Error("failed to get users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	blocked, err := blockRepo.GetBlockedUsernames(c.Request.Context(), owner)
+	if err != nil {
+		s.deps.Logger.Error("failed to get blocked users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	usersByUsername := make(map[string]models.User, len(users))
+	for _, user := range users {
+		usersByUsername[user.Username] = user
+	}
+
+	// favourites of deleted or blocked users are skipped, restricted profiles only show the username
+	result := make([]gen.Favourite, 0, len(favourites))
+	for _, favourite := range favourites {
+		user, ok := usersByUsername[favourite.Username]
+		if !ok || slices.Contains(blocked, favourite.Username) {
+			continue
+		}
+
+		profile := newRestrictedUserProfile(user.Username)
+		if usecases.CanAccess(user, owner, user.Privacy.ProfileVisibility) {
+			profile = newUserProfile(owner, user)
+		}
+
+		tags := favourite.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
+		result = append(result, gen.Favourite{
+			User:      profile,
+			Note:      favourite.Note,
+			Tags:      tags,
+			CreatedAt: favourite.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, gen.FavouritesResponse{Favourites: result})
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+)
+
+func newCircle(viewer string, conversation models.Conversation) gen.Circle {
+	_, joined := conversation.Group.Member(viewer)
+	return gen.Circle{
+		Id:          conversation.Id.Hex(),
+		Name:        conversation.Group.Name,
+		Skill:       conversation.Group.Skill,
+		Description: conversation.Group.Description,
+		MemberCount: len(conversation.Group.Members),
+		MemberLimit: conversation.Group.MemberLimit,
+		Joined:      joined,
+	}
+}
+
+/*
+findGroup gets the group with the id along with the user's membership if they are a member of it, otherwise it responds
+with the error itself.
+*/
+func (s *Server) findGroup(c *gin.Context, username string, id string) (*models.Conversation, *models.GroupMember, error) {
+	conversationId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "group_not_found",
+		})
+		return nil, nil, err
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	conversation, err := conversationRepo.GetConversation(c.Request.Context(), conversationId, username)
+	if err == nil && conversation.Kind != models.ConversationKindGroup {
+		err = repository.ErrConversationNotFound
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrConversationNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "group_not_found",
+			})
+			return nil, nil, err
+		}
+		s.deps.Logger.Error("failed to get group", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return nil, nil, err
+	}
+
+	member, _ := conversation.Group.Member(username)
+	return conversation, &member, nil
+}
+
+/*
+joinGroup adds the user to the group and responds with it, or with the error. Joining a group the user is already a
+member of does nothing.
+*/
+func (s *Server) joinGroup(c *gin.Context, username string, conversation models.Conversation) {
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	joined, err := groupRepo.AddMember(c.Request.Context(), conversation.Id, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyMember) {
+			c.JSON(http.StatusOK, newConversation(username, conversation, nil))
+			return
+		}
+		if errors.Is(err, repository.ErrGroupFull) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "group_full",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrConversationNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "group_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to join group", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, newConversation(username, *joined, nil))
+}
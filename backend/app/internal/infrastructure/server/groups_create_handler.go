@@ -0,0 +1,52 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostGroups(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.CreateGroupRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	group := models.Group{
+		Name:        strings.TrimSpace(body.Name),
+		Skill:       strings.TrimSpace(body.Skill),
+		Description: strings.TrimSpace(*body.Description),
+		Public:      *body.Public,
+		MemberLimit: *body.MemberLimit,
+	}
+	if len(group.Name) == 0 || len(group.Skill) == 0 {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "bad_request",
+		})
+		return
+	}
+
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	conversation, err := groupRepo.CreateGroup(c.Request.Context(), username, group)
+	if err != nil {
+		s.deps.Logger.Error("failed to create group", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newConversation(username, *conversation, nil))
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostGroupsIdInvites(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.CreateInviteRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, member, err := s.findGroup(c, username, id)
+	if err != nil {
+		return
+	}
+	if !usecases.CanManageGroup(member.Role) {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "not_group_admin",
+		})
+		return
+	}
+
+	code, err := usecases.NewInviteCode()
+	if err != nil {
+		s.deps.Logger.Error("failed to generate invite code", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	invite := models.GroupInvite{
+		Code:      code,
+		CreatedBy: username,
+		ExpiresAt: time.Now().Add(time.Duration(*body.ExpiresInHours) * time.Hour),
+	}
+
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	if err := groupRepo.AddInvite(c.Request.Context(), conversation.Id, invite); err != nil {
+		if errors.Is(err, repository.ErrConversationNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "group_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to add invite", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gen.GroupInvite{
+		GroupId:   conversation.Id.Hex(),
+		Code:      invite.Code,
+		ExpiresAt: invite.ExpiresAt,
+	})
+}
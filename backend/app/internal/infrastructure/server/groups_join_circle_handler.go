@@ -0,0 +1,55 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostGroupsIdJoin(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversationId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "group_not_found",
+		})
+		return
+	}
+
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	conversation, err := groupRepo.GetGroup(c.Request.Context(), conversationId)
+	if err != nil {
+		if errors.Is(err, repository.ErrConversationNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "group_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get group", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	// private groups are only joined with an invite, and not given away otherwise
+	if _, ok := conversation.Group.Member(username); !ok && !conversation.Group.Public {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "group_not_found",
+		})
+		return
+	}
+
+	s.joinGroup(c, username, *conversation)
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostGroupsJoin(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.JoinGroupRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	conversation, err := groupRepo.FindGroupByInvite(c.Request.Context(), body.Code)
+	if err != nil {
+		if errors.Is(err, repository.ErrInviteNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "invite_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to find invite", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	s.joinGroup(c, username, *conversation)
+}
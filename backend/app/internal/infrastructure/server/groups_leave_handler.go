@@ -0,0 +1,46 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostGroupsIdLeave(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, member, err := s.findGroup(c, username, id)
+	if err != nil {
+		return
+	}
+
+	// the group has to be handed over first, so it is never left without an owner but empty, in which case whoever joins
+	// it next owns it
+	if member.Role == models.GroupRoleOwner && len(conversation.Group.Members) > 1 {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "owner_cannot_leave",
+		})
+		return
+	}
+
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	if err := groupRepo.RemoveMember(c.Request.Context(), conversation.Id, username); err != nil && !errors.Is(err, repository.ErrNotMember) {
+		s.deps.Logger.Error("failed to leave group", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) DeleteGroupsIdMembersUsername(c *gin.Context, id string, memberUsername string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, member, err := s.findGroup(c, username, id)
+	if err != nil {
+		return
+	}
+
+	removed, ok := conversation.Group.Member(memberUsername)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "member_not_found",
+		})
+		return
+	}
+	if !usecases.CanRemoveMember(member.Role, removed.Role) {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "cannot_remove_member",
+		})
+		return
+	}
+
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	if err := groupRepo.RemoveMember(c.Request.Context(), conversation.Id, memberUsername); err != nil {
+		if errors.Is(err, repository.ErrNotMember) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "member_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to remove group member", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PutGroupsIdMembersUsername(c *gin.Context, id string, memberUsername string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.SetMemberRoleRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, member, err := s.findGroup(c, username, id)
+	if err != nil {
+		return
+	}
+	if member.Role != models.GroupRoleOwner {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "not_group_owner",
+		})
+		return
+	}
+	if memberUsername == username {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "cannot_change_own_role",
+		})
+		return
+	}
+
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	role := models.GroupRole(body.Role)
+	if role == models.GroupRoleOwner {
+		err = groupRepo.TransferOwnership(c.Request.Context(), conversation.Id, username, memberUsername)
+	} else {
+		err = groupRepo.SetMemberRole(c.Request.Context(), conversation.Id, memberUsername, role)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrNotMember) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "member_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to change group member role", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
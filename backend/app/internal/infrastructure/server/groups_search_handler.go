@@ -0,0 +1,84 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostGroupsSearch(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.SearchCirclesRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	user, err := userRepo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	// circles are about a single skill, so they match whether the users found by the search would learn or teach it
+	mode := repository.UserSearchModeExchange
+	if body.Mode != nil {
+		mode = repository.UserSearchMode(*body.Mode)
+	}
+	learning, teaching := repository.SkillFilters(mode, *user, *body.Skills)
+	skills := slices.Compact(slices.Sorted(slices.Values(slices.Concat(learning, teaching))))
+
+	scope := circlesCursorScope(username, skills)
+	query := repository.CircleSearchQuery{Skills: skills, Pagesize: int64(*body.Pagesize)}
+	if body.Cursor != nil {
+		query.After, err = decodeChatCursor(*body.Cursor, scope)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "invalid_cursor",
+			})
+			return
+		}
+	}
+
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	page, err := groupRepo.SearchCircles(c.Request.Context(), query)
+	if err != nil {
+		s.deps.Logger.Error("failed to search circles", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	response := gen.CirclesResponse{Circles: make([]gen.Circle, len(page.Conversations))}
+	for i, conversation := range page.Conversations {
+		response.Circles[i] = newCircle(username, conversation)
+	}
+
+	if page.HasMore {
+		nextCursor, err := encodeChatCursor(chatCursor{Scope: scope, Position: page.Next})
+		if err != nil {
+			s.deps.Logger.Error("failed to encode cursor", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		response.NextCursor = &nextCursor
+	}
+
+	c.JSON(http.StatusOK, response)
+}
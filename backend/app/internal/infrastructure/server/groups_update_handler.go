@@ -0,0 +1,82 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PatchGroupsId(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.UpdateGroupRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, member, err := s.findGroup(c, username, id)
+	if err != nil {
+		return
+	}
+	if !usecases.CanManageGroup(member.Role) {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "not_group_admin",
+		})
+		return
+	}
+
+	group := *conversation.Group
+	if body.Name != nil {
+		group.Name = strings.TrimSpace(*body.Name)
+		if len(group.Name) == 0 {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "bad_request",
+			})
+			return
+		}
+	}
+	if body.Description != nil {
+		group.Description = strings.TrimSpace(*body.Description)
+	}
+	if body.Public != nil {
+		group.Public = *body.Public
+	}
+	if body.MemberLimit != nil {
+		group.MemberLimit = *body.MemberLimit
+	}
+
+	groupRepo := repository.NewGroupRepository(s.deps.Mongo, s.deps.Logger)
+	updated, err := groupRepo.UpdateGroup(c.Request.Context(), conversation.Id, group)
+	if err != nil {
+		if errors.Is(err, repository.ErrMemberLimitLow) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "member_limit_too_low",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrConversationNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "group_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to update group", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, newConversation(username, *updated, nil))
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -36,6 +37,11 @@ func (s *Server) PostLogin(c *gin.Context) {
 	}
 
 	if security.VerifyPassword(body.Password, user.Password) {
+		err = repo.TouchUser(c.Request.Context(), user.Username, time.Now())
+		if err != nil {
+			s.deps.Logger.Error("failed to touch user", slog.Any("error", err))
+		}
+
 		token, err := security.CreateToken(user.Username)
 		if err != nil {
 			s.deps.Logger.Error("failed to create auth token", slog.Any("error", err))
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetMatches(c *gin.Context, params gen.GetMatchesParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	repo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	user, err := repo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	blocked, err := blockRepo.GetBlockedUsernames(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get blocked users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	matches, err := repo.MatchUsers(c.Request.Context(), repository.UserMatchQuery{
+		Username:         username,
+		Teaching:         user.Teaching,
+		Learning:         user.Learning,
+		ExcludeUsernames: blocked,
+		Page:             int64(*params.Page),
+		Pagesize:         int64(*params.Pagesize),
+	})
+	if err != nil {
+		s.deps.Logger.Error("failed to match users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	result := make([]gen.Match, len(matches))
+	for i, match := range matches {
+		result[i] = gen.Match{
+			User:         newUserProfile(username, match.User),
+			Score:        match.Score,
+			TeachesMe:    match.TeachesMe,
+			LearnsFromMe: match.LearnsFromMe,
+		}
+	}
+
+	c.JSON(http.StatusOK, gen.MatchesResponse{Matches: result})
+}
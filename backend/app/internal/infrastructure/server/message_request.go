@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/utils"
+)
+
+const (
+	defaultMessageRequestMessageLimit = 1
+	defaultMessageRequestDailyLimit   = 20
+)
+
+// messageRequestMessageLimit is how many messages the sender of a request may send until it is accepted, set with MESSAGE_REQUEST_MESSAGE_LIMIT.
+func messageRequestMessageLimit() int64 {
+	return positiveEnvInt("MESSAGE_REQUEST_MESSAGE_LIMIT", defaultMessageRequestMessageLimit)
+}
+
+// messageRequestDailyLimit is how many requests a user may send in 24 hours, set with MESSAGE_REQUEST_DAILY_LIMIT.
+func messageRequestDailyLimit() int64 {
+	return positiveEnvInt("MESSAGE_REQUEST_DAILY_LIMIT", defaultMessageRequestDailyLimit)
+}
+
+func positiveEnvInt(key string, fallback int64) int64 {
+	value, err := strconv.ParseInt(utils.GetEnv(key, ""), 10, 64)
+	if err != nil || value < 1 {
+		return fallback
+	}
+	return value
+}
+
+// newMessageRequest converts the request as seen by viewer. Its sender isn't told whether it was declined.
+func newMessageRequest(viewer string, request models.MessageRequest) gen.MessageRequest {
+	status := request.Status
+	if viewer != request.To && status == models.MessageRequestDeclined {
+		status = models.MessageRequestPending
+	}
+	return gen.MessageRequest{
+		From:      request.From,
+		Status:    gen.MessageRequestStatus(status),
+		CreatedAt: request.CreatedAt,
+	}
+}
+
+// acceptMessageRequest accepts the request the conversation started as, on behalf of its recipient.
+func (s *Server) acceptMessageRequest(ctx context.Context, conversation *models.Conversation) error {
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	err := conversationRepo.SetMessageRequestStatus(ctx, conversation.Id, conversation.Request.To, models.MessageRequestAccepted)
+	// a request no longer found was accepted meanwhile
+	if err != nil && !errors.Is(err, repository.ErrRequestNotFound) {
+		return err
+	}
+	conversation.Request.Status = models.MessageRequestAccepted
+	return nil
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) DeleteConversationsIdMessagesMessageId(c *gin.Context, id string, messageId string, params gen.DeleteConversationsIdMessagesMessageIdParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, err := s.findConversation(c, username, id)
+	if err != nil {
+		return
+	}
+
+	message, err := s.findMessage(c, username, *conversation, messageId)
+	if err != nil {
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+
+	if params.Scope == gen.DeleteScopeMe {
+		if err := conversationRepo.HideMessage(c.Request.Context(), *message, username); err != nil {
+			s.deps.Logger.Error("failed to hide message", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+
+		// only the user's other connections are told
+		response := newMessage(*message)
+		s.publishRealtimeEvent(conversation.Id.Hex(), []string{username}, gen.RealtimeEvent{
+			Type:    gen.RealtimeEventTypeMessageDeleted,
+			Message: &response,
+		})
+
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	if message.Sender != username {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "not_message_sender",
+		})
+		return
+	}
+	if message.DeletedAt != nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	deleted, err := conversationRepo.DeleteMessage(c.Request.Context(), *message)
+	if err != nil {
+		// deleted concurrently
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			c.Status(http.StatusNoContent)
+			return
+		}
+		s.deps.Logger.Error("failed to delete message", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	response := newMessage(*deleted)
+	s.publishRealtimeEvent(conversation.Id.Hex(), deleted.Recipients(conversation.Listeners()), gen.RealtimeEvent{
+		Type:    gen.RealtimeEventTypeMessageDeleted,
+		Message: &response,
+	})
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+	"skilly/internal/infrastructure/utils"
+)
+
+const (
+	defaultMessageEditWindow = 15 * time.Minute
+)
+
+// messageEditWindow is how long senders may edit their messages for, set with MESSAGE_EDIT_WINDOW as a duration, e.g. "1h".
+func messageEditWindow() time.Duration {
+	window, err := time.ParseDuration(utils.GetEnv("MESSAGE_EDIT_WINDOW", ""))
+	if err != nil || window < 0 {
+		return defaultMessageEditWindow
+	}
+	return window
+}
+
+func (s *Server) PatchConversationsIdMessagesMessageId(c *gin.Context, id string, messageId string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.EditMessageRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, err := s.findConversation(c, username, id)
+	if err != nil {
+		return
+	}
+
+	message, err := s.findMessage(c, username, *conversation, messageId)
+	if err != nil {
+		return
+	}
+
+	if message.Sender != username {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "not_message_sender",
+		})
+		return
+	}
+	if message.DeletedAt != nil {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "message_deleted",
+		})
+		return
+	}
+	if time.Since(message.CreatedAt) > messageEditWindow() {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "edit_window_expired",
+		})
+		return
+	}
+	if len(strings.TrimSpace(body.Text)) == 0 && len(message.Attachments) == 0 {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "empty_message",
+		})
+		return
+	}
+
+	// an unchanged text isn't an edit
+	if body.Text == message.Text {
+		c.JSON(http.StatusOK, newMessage(*message))
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	edited, err := conversationRepo.EditMessage(c.Request.Context(), *message, body.Text)
+	if err != nil {
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "message_deleted",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to edit message", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	response := newMessage(*edited)
+	s.publishRealtimeEvent(conversation.Id.Hex(), edited.Recipients(conversation.Listeners()), gen.RealtimeEvent{
+		Type:    gen.RealtimeEventTypeMessageEdited,
+		Message: &response,
+	})
+	go s.publishEvent(events.MessagesTopic, username, events.MessageChanged{
+		ConversationId: conversation.Id.Hex(),
+		MessageId:      edited.Id.Hex(),
+		Sender:         username,
+		Kind:           events.MessageEdited,
+		ChangedAt:      time.Now(),
+	})
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetConversationsIdMessages(c *gin.Context, id string, params gen.GetConversationsIdMessagesParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	conversation, err := s.findConversation(c, username, id)
+	if err != nil {
+		return
+	}
+
+	scope := messagesCursorScope(conversation.Id.Hex())
+	var after *repository.ChatPosition
+	if params.Cursor != nil {
+		after, err = decodeChatCursor(*params.Cursor, scope)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "invalid_cursor",
+			})
+			return
+		}
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	page, err := conversationRepo.ListMessages(c.Request.Context(), conversation.Id, username, after, int64(*params.Pagesize))
+	if err != nil {
+		s.deps.Logger.Error("failed to list messages", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	response := gen.MessagesResponse{Messages: make([]gen.Message, len(page.Messages))}
+	for i, message := range page.Messages {
+		response.Messages[i] = newMessage(message)
+	}
+
+	if page.HasMore {
+		nextCursor, err := encodeChatCursor(chatCursor{Scope: scope, Position: page.Next})
+		if err != nil {
+			s.deps.Logger.Error("failed to encode cursor", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		response.NextCursor = &nextCursor
+	}
+
+	c.JSON(http.StatusOK, response)
+}
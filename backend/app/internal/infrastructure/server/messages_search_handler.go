@@ -0,0 +1,125 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostMessagesSearch(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.SearchMessagesRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	text := strings.TrimSpace(body.Query)
+	if len(text) == 0 {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "empty_query",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	blocked, err := blockRepo.GetBlockedUsernames(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get blocked users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	// only the conversations the user can list are searched, leaving out unaccepted requests and blocked users
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	conversationIds, err := conversationRepo.GetConversationIds(c.Request.Context(), username, blocked)
+	if err != nil {
+		s.deps.Logger.Error("failed to get conversations", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	conversationId := ""
+	if body.ConversationId != nil {
+		conversationId = *body.ConversationId
+		id, err := primitive.ObjectIDFromHex(conversationId)
+		if err != nil || !slices.Contains(conversationIds, id) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "conversation_not_found",
+			})
+			return
+		}
+		conversationIds = []primitive.ObjectID{id}
+	}
+
+	query := repository.MessageSearchQuery{
+		Viewer:          username,
+		Text:            text,
+		ConversationIds: conversationIds,
+		Since:           body.Since,
+		Until:           body.Until,
+		Pagesize:        int64(*body.Pagesize),
+	}
+	if body.Sender != nil {
+		query.Sender = *body.Sender
+	}
+
+	scope := messageSearchCursorScope(query, conversationId)
+	if body.Cursor != nil {
+		query.After, err = decodeChatCursor(*body.Cursor, scope)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "invalid_cursor",
+			})
+			return
+		}
+	}
+
+	page, err := conversationRepo.SearchMessages(c.Request.Context(), query)
+	if err != nil {
+		s.deps.Logger.Error("failed to search messages", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	response := gen.MessageSearchResponse{Results: make([]gen.MessageSearchResult, len(page.Messages))}
+	for i, message := range page.Messages {
+		snippet, ranges := usecases.HighlightText(message.Text, text)
+		response.Results[i] = gen.MessageSearchResult{
+			Message:   newMessage(message),
+			Highlight: gen.MessageHighlight{Text: snippet, Ranges: newHighlightRanges(ranges)},
+		}
+	}
+
+	if page.HasMore {
+		nextCursor, err := encodeChatCursor(chatCursor{Scope: scope, Position: page.Next})
+		if err != nil {
+			s.deps.Logger.Error("failed to encode cursor", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		response.NextCursor = &nextCursor
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostConversationsIdMessages(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.SendMessageRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	var attachmentIds []string
+	if body.AttachmentIds != nil {
+		attachmentIds = *body.AttachmentIds
+	}
+	if len(strings.TrimSpace(body.Text)) == 0 && len(attachmentIds) == 0 {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "empty_message",
+		})
+		return
+	}
+
+	conversation, err := s.findConversation(c, username, id)
+	if err != nil {
+		return
+	}
+
+	attachments, err := s.findAttachmentsToSend(c, username, conversation.Id, attachmentIds)
+	if err != nil {
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	sender, err := userRepo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	message, err := conversationRepo.CreateMessage(c.Request.Context(), models.Message{
+		ConversationId: conversation.Id,
+		Sender:         username,
+		Text:           body.Text,
+		Attachments:    attachments,
+		Restricted:     sender.ShadowRestricted,
+	}, messageRequestMessageLimit())
+	if errors.Is(err, repository.ErrRequestPending) {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "message_request_pending",
+		})
+		return
+	}
+	if err != nil {
+		s.deps.Logger.Error("failed to send message", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	// replying to a request accepts it once the reply is sent, while its sender can only send a few messages until then
+	if !conversation.Accepted() && conversation.Request.To == username {
+		if err := s.acceptMessageRequest(c.Request.Context(), conversation); err != nil {
+			s.deps.Logger.Error("failed to accept message request", slog.Any("error", err))
+		}
+	}
+
+	if len(attachments) > 0 {
+		ids := make([]primitive.ObjectID, len(attachments))
+		for i, attachment := range attachments {
+			ids[i] = attachment.Id
+		}
+		// the message is sent either way, the attachments could only be sent again
+		attachmentRepo := repository.NewAttachmentRepository(s.deps.Mongo, s.deps.Logger)
+		if err := attachmentRepo.AttachToMessage(c.Request.Context(), ids, message.Id); err != nil {
+			s.deps.Logger.Error("failed to attach attachments to message", slog.Any("error", err))
+		}
+	}
+
+	response := newMessage(*message)
+	s.publishRealtimeEvent(conversation.Id.Hex(), message.Recipients(conversation.Listeners()), gen.RealtimeEvent{
+		Type:    gen.RealtimeEventTypeMessage,
+		Message: &response,
+	})
+	if conversation.Request != nil && conversation.Request.Status == models.MessageRequestPending && conversation.Request.From == username && !message.Restricted {
+		s.publishRealtimeEvent(conversation.Id.Hex(), []string{conversation.Request.To}, gen.RealtimeEvent{
+			Type:    gen.RealtimeEventTypeMessageRequest,
+			Message: &response,
+		})
+	}
+	go s.publishEvent(events.MessagesTopic, username, events.MessageChanged{
+		ConversationId: conversation.Id.Hex(),
+		MessageId:      message.Id.Hex(),
+		Sender:         username,
+		Kind:           events.MessageSent,
+		ChangedAt:      time.Now(),
+	})
+
+	c.JSON(http.StatusCreated, response)
+}
+
+/*
+findAttachmentsToSend gets the attachments with the ids, in order, if they are ready and were uploaded to the
+conversation by the user without being sent yet, otherwise it responds with the error itself.
+*/
+func (s *Server) findAttachmentsToSend(c *gin.Context, username string, conversationId primitive.ObjectID, ids []string) ([]models.Attachment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	objectIds := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "attachment_not_found",
+			})
+			return nil, err
+		}
+		if !slices.Contains(objectIds, objectId) {
+			objectIds = append(objectIds, objectId)
+		}
+	}
+
+	attachmentRepo := repository.NewAttachmentRepository(s.deps.Mongo, s.deps.Logger)
+	found, err := attachmentRepo.GetAttachments(c.Request.Context(), conversationId, objectIds)
+	if err != nil {
+		s.deps.Logger.Error("failed to get attachments", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return nil, err
+	}
+
+	attachments := make([]models.Attachment, 0, len(objectIds))
+	for _, objectId := range objectIds {
+		index := slices.IndexFunc(found, func(attachment models.Attachment) bool {
+			return attachment.Id == objectId
+		})
+		if index < 0 || found[index].Uploader != username || found[index].MessageId != nil {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "attachment_not_found",
+			})
+			return nil, repository.ErrAttachmentNotFound
+		}
+		if found[index].Status != models.AttachmentStatusReady {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "attachment_not_ready",
+			})
+			return nil, repository.ErrAttachmentNotFound
+		}
+		attachments = append(attachments, found[index])
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetModerationFlags(c *gin.Context, params gen.GetModerationFlagsParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+	if !s.requireModerator(c, username) {
+		return
+	}
+
+	moderationRepo := repository.NewModerationRepository(s.deps.Mongo, s.deps.Logger)
+	flags, err := moderationRepo.ListFlags(c.Request.Context(), int64(*params.Page), int64(*params.Pagesize))
+	if err != nil {
+		s.deps.Logger.Error("failed to list moderation flags", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	response := make([]gen.ModerationFlag, len(flags))
+	for i, flag := range flags {
+		response[i] = gen.ModerationFlag{
+			Id:        flag.Id.Hex(),
+			Kind:      gen.ModerationSubjectKind(flag.Subject.Kind),
+			Username:  flag.Subject.Username,
+			Text:      flag.Text,
+			Findings:  make([]gen.ModerationFinding, len(flag.Findings)),
+			Action:    gen.ModerationAction(flag.Action),
+			CreatedAt: flag.CreatedAt,
+		}
+		if flag.Subject.ConversationId != nil {
+			conversationId := flag.Subject.ConversationId.Hex()
+			response[i].ConversationId = &conversationId
+		}
+		if flag.Subject.MessageId != nil {
+			messageId := flag.Subject.MessageId.Hex()
+			response[i].MessageId = &messageId
+		}
+		for j, finding := range flag.Findings {
+			response[i].Findings[j] = gen.ModerationFinding{
+				RuleId: finding.RuleId.Hex(),
+				Kind:   gen.ModerationRuleKind(finding.Kind),
+				Action: gen.ModerationAction(finding.Action),
+				Match:  finding.Match,
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, gen.ModerationFlagsResponse{Flags: response})
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetModerationMessagesIdHistory(c *gin.Context, id string) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	if !s.requireModerator(c, username) {
+		return
+	}
+
+	messageId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "message_not_found",
+		})
+		return
+	}
+
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	message, err := conversationRepo.FindMessage(c.Request.Context(), messageId)
+	if err != nil {
+		if errors.Is(err, repository.ErrMessageNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "message_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get message", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	revisions, err := conversationRepo.GetMessageRevisions(c.Request.Context(), message.Id)
+	if err != nil {
+		s.deps.Logger.Error("failed to get message revisions", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	response := gen.MessageHistory{
+		Message:   newMessage(*message),
+		Revisions: make([]gen.MessageRevision, len(revisions)),
+	}
+	for i, revision := range revisions {
+		response.Revisions[i] = gen.MessageRevision{
+			Kind:      gen.MessageRevisionKind(revision.Kind),
+			Text:      revision.Text,
+			RevisedAt: revision.RevisedAt,
+		}
+		if len(revision.Attachments) > 0 {
+			attachments := make([]gen.Attachment, len(revision.Attachments))
+			for j, attachment := range revision.Attachments {
+				attachments[j] = newAttachment(attachment)
+			}
+			response.Revisions[i].Attachments = &attachments
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// requireModerator responds with an error unless the user is a moderator, returning whether they are.
+func (s *Server) requireModerator(c *gin.Context, username string) bool {
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	user, err := userRepo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return false
+	}
+	if !user.Moderator {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "not_moderator",
+		})
+		return false
+	}
+	return true
+}
@@ -4,9 +4,13 @@ package server
 import (
 	"log/slog"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"skilly/internal/domain/events"
+	"skilly/internal/domain/models"
 	"skilly/internal/domain/repository"
 	"skilly/internal/infrastructure/gen"
 	"skilly/internal/infrastructure/security"
@@ -39,6 +43,9 @@ func (s *Server) PostProfileEdit(c *gin.Context) {
 	if body.Learning != nil {
 		user.Learning = *body.Learning
 	}
+	if body.Languages != nil {
+		user.Languages = *body.Languages
+	}
 	if body.Password != nil {
 		user.Password, err = security.HashPassword(*body.Password)
 		if err != nil {
@@ -52,6 +59,21 @@ func (s *Server) PostProfileEdit(c *gin.Context) {
 	if body.Teaching != nil {
 		user.Teaching = *body.Teaching
 	}
+	if body.SkillLevels != nil {
+		user.SkillLevels = make([]models.SkillLevel, len(*body.SkillLevels))
+		for i, level := range *body.SkillLevels {
+			if !slices.Contains(user.Teaching, level.Skill) {
+				c.JSON(http.StatusBadRequest, gen.Error{
+					Code: "skill_not_taught",
+				})
+				return
+			}
+			user.SkillLevels[i] = models.SkillLevel{
+				Skill: level.Skill,
+				Level: level.Level,
+			}
+		}
+	}
 
 	err = repo.UpdateUser(c.Request.Context(), *user)
 	if err != nil {
@@ -62,10 +84,16 @@ func (s *Server) PostProfileEdit(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gen.UserProfile{
-		Username: user.Username,
-		Bio: user.Bio,
-		Teaching: user.Teaching,
-		Learning: user.Learning,
+	err = repo.TouchUser(c.Request.Context(), username, time.Now())
+	if err != nil {
+		s.deps.Logger.Error("failed to touch user", slog.Any("error", err))
+	}
+
+	go s.publishEvent(events.UsersTopic, username, events.UserChanged{
+		Username:  username,
+		Kind:      events.UserProfileEdited,
+		ChangedAt: time.Now(),
 	})
+
+	c.JSON(http.StatusOK, newUserProfile(username, *user))
 }
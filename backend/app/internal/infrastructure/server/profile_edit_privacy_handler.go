@@ -0,0 +1,82 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostProfilePrivacy(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.PrivacyEditRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	repo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	user, err := repo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	if body.HiddenFromSearch != nil {
+		user.Privacy.HiddenFromSearch = *body.HiddenFromSearch
+	}
+	if body.ProfileVisibility != nil {
+		user.Privacy.ProfileVisibility = models.Audience(*body.ProfileVisibility)
+	}
+	if body.PictureVisibility != nil {
+		user.Privacy.PictureVisibility = models.Audience(*body.PictureVisibility)
+	}
+	if body.HideBio != nil {
+		user.Privacy.HideBio = *body.HideBio
+	}
+	if body.HideLearning != nil {
+		user.Privacy.HideLearning = *body.HideLearning
+	}
+	if body.HideEndorsements != nil {
+		user.Privacy.HideEndorsements = *body.HideEndorsements
+	}
+	if body.HideProfileViews != nil {
+		user.Privacy.HideProfileViews = *body.HideProfileViews
+	}
+	user.UpdatedAt = time.Now()
+
+	err = repo.UpdateUser(c.Request.Context(), *user)
+	if err != nil {
+		s.deps.Logger.Error("failed to update user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	if user.Privacy.HideProfileViews {
+		viewRepo := repository.NewProfileViewRepository(s.deps.Mongo, s.deps.Logger)
+		err = viewRepo.UnlistViewer(c.Request.Context(), username)
+		if err != nil {
+			s.deps.Logger.Error("failed to unlist profile viewer", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, newPrivacySettings(user.Privacy))
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -8,16 +9,57 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
 	"skilly/internal/infrastructure/gen"
 	"skilly/internal/infrastructure/security"
 )
 
 func (s *Server) GetProfileGetPicture(c *gin.Context, params gen.GetProfileGetPictureParams) {
-	_, err := security.AuthUser(c, s.deps)
+	viewer, err := security.AuthUser(c, s.deps)
 	if err != nil {
 		return
 	}
 
+	repo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	owner, err := repo.GetUserByUsername(c.Request.Context(), params.Username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "username_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	canInteract, err := usecases.CanInteract(c.Request.Context(), blockRepo, viewer, owner.Username)
+	if err != nil {
+		s.deps.Logger.Error("failed to check blocks", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	if !canInteract {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "username_not_found",
+		})
+		return
+	}
+
+	if !usecases.CanAccess(*owner, viewer, owner.Privacy.PictureVisibility) {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "picture_restricted",
+		})
+		return
+	}
+
 	path := fmt.Sprintf("pfp/%s", params.Username)
 
 	url, err := s.deps.S3.GenerateDownloadUrl(c.Request.Context(), path, time.Minute*15)
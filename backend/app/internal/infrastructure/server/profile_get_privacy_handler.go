@@ -0,0 +1,52 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetProfilePrivacy(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	repo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	user, err := repo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, newPrivacySettings(user.Privacy))
+}
+
+func newPrivacySettings(privacy models.PrivacySettings) gen.PrivacySettings {
+	return gen.PrivacySettings{
+		HiddenFromSearch:  privacy.HiddenFromSearch,
+		ProfileVisibility: newAudience(privacy.ProfileVisibility),
+		PictureVisibility: newAudience(privacy.PictureVisibility),
+		HideBio:           privacy.HideBio,
+		HideLearning:      privacy.HideLearning,
+		HideProfileViews:  privacy.HideProfileViews,
+		HideEndorsements:  privacy.HideEndorsements,
+	}
+}
+
+// newAudience maps the stored audience to the API one, treating a missing value as everyone.
+func newAudience(audience models.Audience) gen.Audience {
+	if audience == "" {
+		return gen.AudienceEveryone
+	}
+	return gen.Audience(audience)
+}
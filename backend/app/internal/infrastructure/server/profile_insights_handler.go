@@ -0,0 +1,104 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+const (
+	recentProfileViewersLimit = 50
+)
+
+func (s *Server) GetProfileInsights(c *gin.Context, params gen.GetProfileInsightsParams) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	user, err := userRepo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	// opting out works both ways: users who don't share their views don't see others' either
+	if user.Privacy.HideProfileViews {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "profile_views_hidden",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	blocked, err := blockRepo.GetBlockedUsernames(c.Request.Context(), username)
+	if err != nil {
+		s.deps.Logger.Error("failed to get blocked users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	firstDay := today.AddDate(0, 0, -int(*params.Days)+1)
+	since := firstDay.Format(models.ProfileViewsDayFormat)
+
+	viewRepo := repository.NewProfileViewRepository(s.deps.Mongo, s.deps.Logger)
+	stats, err := viewRepo.GetDailyStats(c.Request.Context(), username, since)
+	if err != nil {
+		s.deps.Logger.Error("failed to get profile view stats", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	viewers, err := viewRepo.ListRecentViewers(c.Request.Context(), username, since, blocked, recentProfileViewersLimit)
+	if err != nil {
+		s.deps.Logger.Error("failed to list profile viewers", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	statsByDay := make(map[string]models.ProfileViewStats, len(stats))
+	for _, dayStats := range stats {
+		statsByDay[dayStats.Day] = dayStats
+	}
+
+	days := make([]gen.ProfileViewsDay, 0, *params.Days)
+	for day := firstDay; !day.After(today); day = day.AddDate(0, 0, 1) {
+		dayStats := statsByDay[day.Format(models.ProfileViewsDayFormat)]
+		days = append(days, gen.ProfileViewsDay{
+			Day:           day.Format(models.ProfileViewsDayFormat),
+			Views:         dayStats.Views,
+			UniqueViewers: dayStats.UniqueViewers,
+		})
+	}
+
+	recentViewers := make([]gen.ProfileViewer, len(viewers))
+	for i, viewer := range viewers {
+		recentViewers[i] = gen.ProfileViewer{
+			Username: viewer.Viewer,
+			ViewedAt: viewer.LastViewedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, gen.ProfileInsightsResponse{
+		Days:          days,
+		RecentViewers: recentViewers,
+	})
+}
@@ -3,16 +3,20 @@ package server
 import (
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"skilly/internal/domain/events"
 	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
 	"skilly/internal/infrastructure/gen"
 	"skilly/internal/infrastructure/security"
 )
 
 func (s *Server) PostProfileView(c *gin.Context, params gen.PostProfileViewParams) {
-	_, err := security.AuthUser(c, s.deps)
+	viewer, err := security.AuthUser(c, s.deps)
 	if err != nil {
 		return
 	}
@@ -34,10 +38,38 @@ func (s *Server) PostProfileView(c *gin.Context, params gen.PostProfileViewParam
 		return
 	}
 
-	c.JSON(http.StatusOK, gen.UserProfile{
-		Username: user.Username,
-		Bio: user.Bio,
-		Teaching: user.Teaching,
-		Learning: user.Learning,
-	})
-}
\ No newline at end of file
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	canInteract, err := usecases.CanInteract(c.Request.Context(), blockRepo, viewer, user.Username)
+	if err != nil {
+		s.deps.Logger.Error("failed to check blocks", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	// blocked users are told the profile doesn't exist
+	if !canInteract {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "username_not_found",
+		})
+		return
+	}
+
+	if !usecases.CanAccess(*user, viewer, user.Privacy.ProfileVisibility) {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "profile_restricted",
+		})
+		return
+	}
+
+	if viewer != user.Username {
+		go s.publishEvent(events.ProfileViewsTopic, user.Username, events.ProfileViewed{
+			Id:       primitive.NewObjectID().Hex(),
+			Viewer:   viewer,
+			Owner:    user.Username,
+			ViewedAt: time.Now(),
+		})
+	}
+
+	c.JSON(http.StatusOK, newUserProfile(viewer, *user))
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetRecommendations(c *gin.Context) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	recommendationRepo := repository.NewRecommendationRepository(s.deps.Mongo, s.deps.Logger)
+	recommendations, err := recommendationRepo.GetRecommendations(c.Request.Context(), owner)
+	if err != nil {
+		s.deps.Logger.Error("failed to get recommendations", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	if recommendations == nil {
+		c.JSON(http.StatusOK, gen.RecommendationsResponse{Recommendations: []gen.Recommendation{}})
+		return
+	}
+
+	usernames := make([]string, len(recommendations.Items))
+	for i, recommendation := range recommendations.Items {
+		usernames[i] = recommendation.Username
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	users, err := userRepo.GetUsersByUsernames(c.Request.Context(), usernames)
+	if err != nil {
+		s.deps.Logger.Error("failed to get users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	blocked, err := blockRepo.GetBlockedUsernames(c.Request.Context(), owner)
+	if err != nil {
+		s.deps.Logger.Error("failed to get blocked users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	usersByUsername := make(map[string]models.User, len(users))
+	for _, user := range users {
+		usersByUsername[user.Username] = user
+	}
+
+	// recommendations are computed offline, so users deleted, blocked or hidden since then are skipped
+	result := make([]gen.Recommendation, 0, len(recommendations.Items))
+	for _, recommendation := range recommendations.Items {
+		user, ok := usersByUsername[recommendation.Username]
+		if !ok || slices.Contains(blocked, user.Username) || user.Privacy.HiddenFromSearch {
+			continue
+		}
+		if !usecases.CanAccess(user, owner, user.Privacy.ProfileVisibility) {
+			continue
+		}
+
+		reasons := make([]gen.RecommendationReason, len(recommendation.Reasons))
+		for i, reason := range recommendation.Reasons {
+			reasons[i] = gen.RecommendationReason{
+				Kind:  gen.RecommendationReasonKind(reason.Kind),
+				Terms: reason.Terms,
+			}
+		}
+
+		result = append(result, gen.Recommendation{
+			User:    newUserProfile(owner, user),
+			Score:   recommendation.Score,
+			Reasons: reasons,
+		})
+	}
+
+	response := gen.RecommendationsResponse{Recommendations: result}
+	if !recommendations.ComputedAt.IsZero() {
+		response.ComputedAt = &recommendations.ComputedAt
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"skilly/internal/domain/events"
 	"skilly/internal/domain/models"
 	"skilly/internal/domain/repository"
 	"skilly/internal/domain/usecases"
@@ -46,17 +47,33 @@ func (s *Server) PostRegister(c *gin.Context) {
 	}
 
 	user := models.User{
-		Username:  body.Username,
-		Password:  body.Password,
-		Bio:       body.Bio,
-		Teaching:  body.Teaching,
-		Learning:  body.Learning,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		Username:     body.Username,
+		Password:     body.Password,
+		Bio:          body.Bio,
+		Teaching:     body.Teaching,
+		Learning:     body.Learning,
+		Languages:    []string{},
+		SkillLevels:  []models.SkillLevel{},
+		Contacts:     []string{},
+		Privacy:      models.DefaultPrivacySettings(),
+		Endorsements: []models.SkillEndorsements{},
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+		LastActiveAt: time.Now(),
+	}
+
+	if body.Languages != nil {
+		user.Languages = *body.Languages
 	}
 
 	err = usecases.RegisterUser(c.Request.Context(), repo, user)
 	if err != nil {
+		if errors.Is(err, repository.ErrUserAlreadyExists) {
+			c.JSON(http.StatusConflict, gen.Error{
+				Code: "username_already_exists",
+			})
+			return
+		}
 		s.deps.Logger.Error("failed to register user", slog.Any("error", err))
 		c.JSON(http.StatusInternalServerError, gen.Error{
 			Code: "internal_server_error",
@@ -64,6 +81,12 @@ func (s *Server) PostRegister(c *gin.Context) {
 		return
 	}
 
+	go s.publishEvent(events.UsersTopic, user.Username, events.UserChanged{
+		Username:  user.Username,
+		Kind:      events.UserRegistered,
+		ChangedAt: user.CreatedAt,
+	})
+
 	token, err := security.CreateToken(user.Username)
 	if err != nil {
 		s.deps.Logger.Error("failed to create auth token", slog.Any("error", err))
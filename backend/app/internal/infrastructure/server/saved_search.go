@@ -0,0 +1,34 @@
+package server
+
+import (
+	"cmp"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/infrastructure/gen"
+)
+
+func newSavedSearch(search models.SavedSearch) gen.SavedSearch {
+	return gen.SavedSearch{
+		Id:            search.Id.Hex(),
+		Name:          search.Name,
+		Username:      search.Username,
+		UsernameInfix: search.UsernameInfix,
+		Skills:        search.Skills,
+		Mode:          gen.SearchMode(cmp.Or(search.Mode, string(gen.SearchModeExchange))),
+		Languages:     search.Languages,
+		Query:         search.Query,
+		Notify:        search.Notify,
+		CreatedAt:     search.CreatedAt,
+	}
+}
+
+func newSearchAlert(alert models.SearchAlert) gen.SearchAlert {
+	return gen.SearchAlert{
+		Id:              alert.Id.Hex(),
+		SavedSearchId:   alert.SavedSearchId.Hex(),
+		SavedSearchName: alert.SavedSearchName,
+		Username:        alert.Username,
+		Read:            alert.Read,
+		CreatedAt:       alert.CreatedAt,
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+const (
+	maxSavedSearches = 20
+)
+
+func (s *Server) PostSavedSearches(c *gin.Context) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.SavedSearchRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	savedSearchRepo := repository.NewSavedSearchRepository(s.deps.Mongo, s.deps.Logger)
+	count, err := savedSearchRepo.CountSavedSearches(c.Request.Context(), owner)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	if count >= maxSavedSearches {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "too_many_saved_searches",
+		})
+		return
+	}
+
+	search := models.SavedSearch{
+		Owner:     owner,
+		Name:      body.Name,
+		Skills:    []string{},
+		Languages: []string{},
+	}
+	if body.Search.Username != nil {
+		search.Username = *body.Search.Username
+	}
+	if body.Search.UsernameInfix != nil {
+		search.UsernameInfix = *body.Search.UsernameInfix
+	}
+	if body.Search.Skills != nil {
+		search.Skills = *body.Search.Skills
+	}
+	if body.Search.Mode != nil {
+		search.Mode = string(*body.Search.Mode)
+	}
+	if body.Search.Languages != nil {
+		search.Languages = *body.Search.Languages
+	}
+	if body.Search.Query != nil {
+		search.Query = strings.TrimSpace(*body.Search.Query)
+	}
+	if body.Notify != nil {
+		search.Notify = *body.Notify
+	}
+
+	saved, err := savedSearchRepo.CreateSavedSearch(c.Request.Context(), search)
+	if err != nil {
+		s.deps.Logger.Error("failed to save search", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newSavedSearch(*saved))
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostSavedSearchesIdDelete(c *gin.Context, id string) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	searchId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "saved_search_not_found",
+		})
+		return
+	}
+
+	savedSearchRepo := repository.NewSavedSearchRepository(s.deps.Mongo, s.deps.Logger)
+	err = savedSearchRepo.DeleteSavedSearch(c.Request.Context(), owner, searchId)
+	if err != nil {
+		if errors.Is(err, repository.ErrSavedSearchNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "saved_search_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to delete saved search", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetSavedSearches(c *gin.Context) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	savedSearchRepo := repository.NewSavedSearchRepository(s.deps.Mongo, s.deps.Logger)
+	searches, err := savedSearchRepo.ListSavedSearches(c.Request.Context(), owner)
+	if err != nil {
+		s.deps.Logger.Error("failed to list saved searches", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	result := make([]gen.SavedSearch, len(searches))
+	for i, search := range searches {
+		result[i] = newSavedSearch(search)
+	}
+
+	c.JSON(http.StatusOK, gen.SavedSearchesResponse{
+		SavedSearches: result,
+	})
+}
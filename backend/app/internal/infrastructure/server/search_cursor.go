@@ -0,0 +1,45 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/security"
+)
+
+// searchCursor is the payload of the opaque cursors handed out by search.
+type searchCursor struct {
+	Search   string                        `bson:"search"` // Fingerprint of the search the cursor continues.
+	Position repository.UserSearchPosition `bson:"position"`
+}
+
+// searchFingerprint identifies the parameters of a search, so its cursors can't be used to continue another one.
+func searchFingerprint(query repository.UserSearchQuery) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%q|%q|%t|%q|%q|%q|%q|%q", query.ExcludeUsername, query.Username, query.UsernameInfix, query.Learning, query.Teaching, query.Languages, query.Text, query.Sort))
+	return hex.EncodeToString(sum[:])
+}
+
+func encodeSearchCursor(cursor searchCursor) (string, error) {
+	payload, err := bson.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return security.SignCursor(payload), nil
+}
+
+func decodeSearchCursor(encoded string) (*searchCursor, error) {
+	payload, err := security.VerifyCursor(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor searchCursor
+	if err := bson.Unmarshal(payload, &cursor); err != nil {
+		return nil, security.ErrInvalidCursor
+	}
+	return &cursor, nil
+}
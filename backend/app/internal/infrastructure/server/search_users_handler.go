@@ -1,15 +1,31 @@
 package server
 
 import (
+	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
 	"skilly/internal/infrastructure/gen"
 	"skilly/internal/infrastructure/security"
 )
 
+const (
+	searchTotalLimit = 1000
+)
+
+var searchSorts = map[gen.SearchSort]repository.UserSearchSort{
+	gen.SearchSortNewest:         repository.UserSearchSortNewest,
+	gen.SearchSortRecentlyActive: repository.UserSearchSortRecentlyActive,
+	gen.SearchSortEndorsements:   repository.UserSearchSortEndorsements,
+	gen.SearchSortAlphabetical:   repository.UserSearchSortAlphabetical,
+}
+
 func (s *Server) PostSearch(c *gin.Context) {
 	username, err := security.AuthUser(c, s.deps)
 	if err != nil {
@@ -28,7 +44,120 @@ func (s *Server) PostSearch(c *gin.Context) {
 		return
 	}
 
-	searchResultRaw, err := repo.SearchUsers(c.Request.Context(), user.Username, *body.Username, user.Teaching, *body.Skills, int64(*body.Page), int64(*body.Pagesize))
+	err = repo.TouchUser(c.Request.Context(), user.Username, time.Now())
+	if err != nil {
+		s.deps.Logger.Error("failed to touch user", slog.Any("error", err))
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	blocked, err := blockRepo.GetBlockedUsernames(c.Request.Context(), user.Username)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	text := ""
+	if body.Query != nil {
+		text = strings.TrimSpace(*body.Query)
+	}
+
+	sort := repository.UserSearchSortDefault
+	if body.Sort != nil && *body.Sort != gen.SearchSortDefault {
+		sort = searchSorts[*body.Sort]
+	} else if len(text) > 0 {
+		sort = repository.UserSearchSortRelevance
+	}
+
+	mode := repository.UserSearchModeExchange
+	if body.Mode != nil {
+		mode = repository.UserSearchMode(*body.Mode)
+	}
+	learning, teaching := repository.SkillFilters(mode, *user, *body.Skills)
+
+	query := repository.UserSearchQuery{
+		ExcludeUsername:   user.Username,
+		Username:          *body.Username,
+		UsernameInfix:     *body.UsernameInfix,
+		Learning:          learning,
+		Teaching:          teaching,
+		Languages:         *body.Languages,
+		Text:              text,
+		TextStems:         usecases.HighlightTerms(text),
+		ExcludeUsernames:  blocked,
+		Sort:              sort,
+		Page:              int64(*body.Page),
+		Pagesize:          int64(*body.Pagesize),
+	}
+	fingerprint := searchFingerprint(query)
+
+	if body.Cursor != nil {
+		cursor, err := decodeSearchCursor(*body.Cursor)
+		if err != nil || cursor.Search != fingerprint {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "invalid_cursor",
+			})
+			return
+		}
+		query.After = &cursor.Position
+	}
+
+	searchPage, err := repo.SearchUsers(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	searchResultRaw := searchPage.Users
+
+	response := gen.SearchResponse{HasMore: searchPage.HasMore}
+	if searchPage.HasMore {
+		nextCursor, err := encodeSearchCursor(searchCursor{Search: fingerprint, Position: searchPage.Next})
+		if err != nil {
+			s.deps.Logger.Error("failed to encode search cursor", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		response.NextCursor = &nextCursor
+	}
+
+	if *body.IncludeTotal {
+		total, err := repo.CountUsers(c.Request.Context(), query, searchTotalLimit)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		response.Total = &total
+	}
+
+	if *body.IncludeFacets {
+		facets, err := repo.GetSearchFacets(c.Request.Context(), query, int64(*body.FacetSize))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+		response.Facets = &gen.SearchFacets{
+			Teaching:  newFacetCounts(facets.Teaching),
+			Learning:  newFacetCounts(facets.Learning),
+			Languages: newFacetCounts(facets.Languages),
+		}
+	}
+
+	usernames := make([]string, len(searchResultRaw))
+	for i, found := range searchResultRaw {
+		usernames[i] = found.Username
+	}
+
+	favouriteRepo := repository.NewFavouriteRepository(s.deps.Mongo, s.deps.Logger)
+	favourited, err := favouriteRepo.GetFavouritedUsernames(c.Request.Context(), user.Username, usernames)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gen.Error{
 			Code: "internal_server_error",
@@ -36,15 +165,31 @@ func (s *Server) PostSearch(c *gin.Context) {
 		return
 	}
 
-	searchResult := make([]gen.UserProfile, len(searchResultRaw))
-	for i, user := range searchResultRaw {
-		searchResult[i] = gen.UserProfile{
-			Username: user.Username,
-			Bio: user.Bio,
-			Teaching: user.Teaching,
-			Learning: user.Learning,
+	searchResult := make([]gen.UserProfile, 0, len(searchResultRaw))
+	for _, found := range searchResultRaw {
+		profile := newUserProfile(user.Username, found)
+		isFavourited := slices.Contains(favourited, found.Username)
+		profile.Favourited = &isFavourited
+
+		if len(text) > 0 {
+			highlights := newHighlights(usecases.HighlightUser(found, text), found, user.Username)
+			profile.Highlights = &highlights
 		}
+
+		searchResult = append(searchResult, profile)
 	}
 
-	c.JSON(http.StatusOK, gen.SearchResponse{Users: searchResult})
+	response.Users = searchResult
+	c.JSON(http.StatusOK, response)
+}
+
+func newFacetCounts(counts []repository.FacetCount) []gen.FacetCount {
+	result := make([]gen.FacetCount, len(counts))
+	for i, count := range counts {
+		result[i] = gen.FacetCount{
+			Value: count.Value,
+			Count: count.Count,
+		}
+	}
+	return result
 }
\ No newline at end of file
@@ -6,6 +6,7 @@ import (
 
 	"skilly/internal/infrastructure/dependencies"
 	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/realtime"
 
 	"github.com/gin-gonic/gin"
 )
@@ -13,10 +14,11 @@ import (
 type Server struct {
 	gen.ServerInterface
 	deps *dependencies.Dependencies
+	hub  *realtime.Hub
 }
 
-func NewServer(deps *dependencies.Dependencies) *Server {
-	return &Server{deps: deps}
+func NewServer(deps *dependencies.Dependencies, hub *realtime.Hub) *Server {
+	return &Server{deps: deps, hub: hub}
 }
 
 func BindJSONAndHandleError[T any](c *gin.Context, deps *dependencies.Dependencies) (T, error) {
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+)
+
+// newUserProfile builds the profile of the user as seen by the viewer, leaving out the fields hidden by the owner.
+func newUserProfile(viewer string, user models.User) gen.UserProfile {
+	profile := gen.UserProfile{
+		Username:  user.Username,
+		Bio:       user.Bio,
+		Teaching:  user.Teaching,
+		Learning:  user.Learning,
+		Languages: user.Languages,
+	}
+	if profile.Languages == nil {
+		profile.Languages = []string{}
+	}
+
+	skillLevels := newSkillLevels(user.GetSkillLevels())
+	profile.SkillLevels = &skillLevels
+
+	endorsements := newSkillEndorsements(user.GetEndorsements())
+	if user.Username == viewer || !user.Privacy.HideEndorsements {
+		profile.Endorsements = &endorsements
+	}
+
+	if user.Username == viewer {
+		return profile
+	}
+	if user.Privacy.HideBio || user.BioHidden {
+		profile.Bio = ""
+	}
+	if user.Privacy.HideLearning {
+		profile.Learning = []string{}
+	}
+
+	return profile
+}
+
+func newSkillEndorsements(endorsements []models.SkillEndorsements) []gen.SkillEndorsements {
+	result := make([]gen.SkillEndorsements, len(endorsements))
+	for i, endorsement := range endorsements {
+		result[i] = gen.SkillEndorsements{
+			Skill: endorsement.Skill,
+			Count: endorsement.Count,
+		}
+	}
+	return result
+}
+
+func newSkillLevels(levels []models.SkillLevel) []gen.SkillLevel {
+	result := make([]gen.SkillLevel, len(levels))
+	for i, level := range levels {
+		result[i] = gen.SkillLevel{
+			Skill: level.Skill,
+			Level: level.Level,
+		}
+	}
+	return result
+}
+
+// newHighlights maps the highlights of the user, leaving out the ones of fields hidden from the viewer.
+func newHighlights(highlights []usecases.Highlight, user models.User, viewer string) []gen.Highlight {
+	result := []gen.Highlight{}
+	for _, highlight := range usecases.VisibleHighlights(highlights, user, viewer) {
+		result = append(result, gen.Highlight{
+			Field:  gen.HighlightField(highlight.Field),
+			Text:   highlight.Text,
+			Ranges: newHighlightRanges(highlight.Ranges),
+		})
+	}
+	return result
+}
+
+func newHighlightRanges(ranges []usecases.HighlightRange) []gen.HighlightRange {
+	result := make([]gen.HighlightRange, len(ranges))
+	for i, r := range ranges {
+		result[i] = gen.HighlightRange{
+			Start: int32(r.Start),
+			End:   int32(r.End),
+		}
+	}
+	return result
+}
+
+// newRestrictedUserProfile builds the profile shown in place of one the viewer is not allowed to see.
+func newRestrictedUserProfile(username string) gen.UserProfile {
+	return gen.UserProfile{
+		Username:  username,
+		Teaching:  []string{},
+		Learning:  []string{},
+		Languages: []string{},
+	}
+}
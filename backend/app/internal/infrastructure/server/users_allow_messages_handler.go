@@ -0,0 +1,75 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostUsersUsernameAllowMessages(c *gin.Context, username string) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	if username == owner {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "cannot_allow_self",
+		})
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	_, err = userRepo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "username_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	allowedSenderRepo := repository.NewAllowedSenderRepository(s.deps.Mongo, s.deps.Logger)
+	err = allowedSenderRepo.Allow(c.Request.Context(), owner, username)
+	if err != nil {
+		s.deps.Logger.Error("failed to allow sender", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	// a request already sent by the user is accepted along
+	conversationRepo := repository.NewConversationRepository(s.deps.Mongo, s.deps.Logger)
+	conversation, err := conversationRepo.FindDirectConversation(c.Request.Context(), owner, username)
+	if err != nil && !errors.Is(err, repository.ErrConversationNotFound) {
+		s.deps.Logger.Error("failed to find conversation", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	if conversation != nil && !conversation.Accepted() && conversation.Request.To == owner {
+		if err := s.acceptMessageRequest(c.Request.Context(), conversation); err != nil {
+			s.deps.Logger.Error("failed to accept message request", slog.Any("error", err))
+			c.JSON(http.StatusInternalServerError, gen.Error{
+				Code: "internal_server_error",
+			})
+			return
+		}
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostUsersUsernameBlock(c *gin.Context, username string) {
+	blocker, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	if username == blocker {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "cannot_block_self",
+		})
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	_, err = userRepo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "username_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	err = usecases.BlockUser(c.Request.Context(), userRepo, blockRepo, blocker, username)
+	if err != nil {
+		s.deps.Logger.Error("failed to block user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostUsersUsernameDisallowMessages(c *gin.Context, username string) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	allowedSenderRepo := repository.NewAllowedSenderRepository(s.deps.Mongo, s.deps.Logger)
+	err = allowedSenderRepo.Disallow(c.Request.Context(), owner, username)
+	if err != nil {
+		s.deps.Logger.Error("failed to disallow sender", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostUsersUsernameEndorse(c *gin.Context, username string) {
+	endorser, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.EndorseRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	if username == endorser {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "cannot_endorse_self",
+		})
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	user, err := userRepo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "username_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	canInteract, err := usecases.CanInteract(c.Request.Context(), blockRepo, endorser, username)
+	if err != nil {
+		s.deps.Logger.Error("failed to check blocks", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	if !canInteract {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "username_not_found",
+		})
+		return
+	}
+
+	if !usecases.CanAccess(*user, endorser, user.Privacy.ProfileVisibility) {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "profile_restricted",
+		})
+		return
+	}
+
+	if !slices.Contains(user.Teaching, body.Skill) {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "skill_not_taught",
+		})
+		return
+	}
+
+	endorsement := models.Endorsement{
+		Endorser: endorser,
+		Endorsee: username,
+		Skill:    body.Skill,
+	}
+	if body.Comment != nil {
+		endorsement.Comment = *body.Comment
+	}
+
+	endorsementRepo := repository.NewEndorsementRepository(s.deps.Mongo, s.deps.Logger)
+	err = usecases.Endorse(c.Request.Context(), userRepo, endorsementRepo, endorsement)
+	if err != nil {
+		s.deps.Logger.Error("failed to endorse user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) GetUsersUsernameEndorsements(c *gin.Context, username string, params gen.GetUsersUsernameEndorsementsParams) {
+	viewer, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	user, err := userRepo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "username_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	canInteract, err := usecases.CanInteract(c.Request.Context(), blockRepo, viewer, username)
+	if err != nil {
+		s.deps.Logger.Error("failed to check blocks", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	if !canInteract {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "username_not_found",
+		})
+		return
+	}
+
+	if !usecases.CanAccess(*user, viewer, user.Privacy.ProfileVisibility) {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "profile_restricted",
+		})
+		return
+	}
+	if user.Privacy.HideEndorsements && viewer != username {
+		c.JSON(http.StatusForbidden, gen.Error{
+			Code: "endorsements_hidden",
+		})
+		return
+	}
+
+	// endorsements by users blocked by or blocking the viewer are left out
+	blocked, err := blockRepo.GetBlockedUsernames(c.Request.Context(), viewer)
+	if err != nil {
+		s.deps.Logger.Error("failed to get blocked users", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	skill := ""
+	if params.Skill != nil {
+		skill = *params.Skill
+	}
+
+	endorsementRepo := repository.NewEndorsementRepository(s.deps.Mongo, s.deps.Logger)
+	endorsements, err := endorsementRepo.ListEndorsements(c.Request.Context(), username, skill, blocked, int64(*params.Page), int64(*params.Pagesize))
+	if err != nil {
+		s.deps.Logger.Error("failed to list endorsements", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	result := make([]gen.Endorsement, len(endorsements))
+	for i, endorsement := range endorsements {
+		result[i] = gen.Endorsement{
+			Endorser:  endorsement.Endorser,
+			Skill:     endorsement.Skill,
+			Comment:   endorsement.Comment,
+			CreatedAt: endorsement.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, gen.EndorsementsResponse{Endorsements: result})
+}
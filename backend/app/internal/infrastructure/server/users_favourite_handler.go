@@ -0,0 +1,90 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostUsersUsernameFavourite(c *gin.Context, username string) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.FavouriteRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	if username == owner {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "cannot_favourite_self",
+		})
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	_, err = userRepo.GetUserByUsername(c.Request.Context(), username)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			c.JSON(http.StatusBadRequest, gen.Error{
+				Code: "username_not_found",
+			})
+			return
+		}
+		s.deps.Logger.Error("failed to get user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(s.deps.Mongo, s.deps.Logger)
+	canInteract, err := usecases.CanInteract(c.Request.Context(), blockRepo, owner, username)
+	if err != nil {
+		s.deps.Logger.Error("failed to check blocks", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+	if !canInteract {
+		c.JSON(http.StatusBadRequest, gen.Error{
+			Code: "username_not_found",
+		})
+		return
+	}
+
+	favourite := models.Favourite{
+		Owner:    owner,
+		Username: username,
+		Tags:     []string{},
+	}
+	if body.Note != nil {
+		favourite.Note = *body.Note
+	}
+	if body.Tags != nil {
+		favourite.Tags = *body.Tags
+	}
+
+	favouriteRepo := repository.NewFavouriteRepository(s.deps.Mongo, s.deps.Logger)
+	err = favouriteRepo.SaveFavourite(c.Request.Context(), favourite)
+	if err != nil {
+		s.deps.Logger.Error("failed to save favourite", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostUsersUsernameUnendorse(c *gin.Context, username string) {
+	endorser, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	body, err := BindJSONAndHandleError[gen.UnendorseRequest](c, s.deps)
+	if err != nil {
+		return
+	}
+
+	userRepo := repository.NewUserRepository(s.deps.Mongo, s.deps.Logger)
+	endorsementRepo := repository.NewEndorsementRepository(s.deps.Mongo, s.deps.Logger)
+	err = usecases.Unendorse(c.Request.Context(), userRepo, endorsementRepo, endorser, username, body.Skill)
+	if err != nil {
+		s.deps.Logger.Error("failed to unendorse user", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/gen"
+	"skilly/internal/infrastructure/security"
+)
+
+func (s *Server) PostUsersUsernameUnfavourite(c *gin.Context, username string) {
+	owner, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	favouriteRepo := repository.NewFavouriteRepository(s.deps.Mongo, s.deps.Logger)
+	err = favouriteRepo.RemoveFavourite(c.Request.Context(), owner, username)
+	if err != nil {
+		s.deps.Logger.Error("failed to remove favourite", slog.Any("error", err))
+		c.JSON(http.StatusInternalServerError, gen.Error{
+			Code: "internal_server_error",
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"skilly/internal/infrastructure/security"
+)
+
+var (
+	// same as the origins allowed by CORS, which doesn't apply to WebSockets
+	websocketAllowedOrigins = []string{"http://localhost:3000"}
+
+	upgrader = websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			// clients other than browsers don't send an origin
+			return origin == "" || slices.Contains(websocketAllowedOrigins, origin)
+		},
+	}
+)
+
+func (s *Server) GetWs(c *gin.Context) {
+	username, err := security.AuthUser(c, s.deps)
+	if err != nil {
+		return
+	}
+
+	ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader has already responded
+		s.deps.Logger.Warn("failed to upgrade to websocket", slog.Any("error", err))
+		return
+	}
+
+	s.hub.ServeWebSocket(ws, username)
+}
@@ -0,0 +1,205 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"log/slog"
+	"strings"
+
+	_ "image/gif" // Registers the decoders of the images previews are generated for, along with JPEG.
+	_ "image/png"
+
+	kafkalib "github.com/segmentio/kafka-go"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/dependencies"
+)
+
+const (
+	attachmentCheckerGroupID = "attachment-checker"
+	previewMaxDimension      = 320
+	previewMaxSourcePixels   = 50_000_000 // Larger images get no preview rather than being decoded.
+	previewQuality           = 80
+)
+
+// minioEvent is the part of a MinIO bucket notification the checkers need.
+type minioEvent struct {
+	EventName string `json:"EventName"`
+	Key       string `json:"Key"` // Bucket name and object key.
+}
+
+/*
+AttachmentChecker checks the files uploaded for chat attachments, deleting those too large or of an unsupported type,
+and generates previews of images. Files are final once checked: uploading one again rejects its attachment, since the
+new content could otherwise be served as checked, after it was sent.
+*/
+func AttachmentChecker(ctx context.Context, deps *dependencies.Dependencies) {
+	consumer, err := deps.Kafka.NewConsumer(minioEventsTopic, attachmentCheckerGroupID)
+	if err != nil {
+		deps.Logger.Error("failed to create consumer", slog.Any("error", err))
+		return
+	}
+	defer consumer.Close()
+
+	for {
+		msg, err := consumer.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				deps.Logger.Info("context canceled")
+				return
+			} else {
+				deps.Logger.Error("failed to read message", slog.Any("error", err))
+				continue
+			}
+		}
+		handleAttachmentUploaded(ctx, msg, deps)
+		consumer.CommitMessages(ctx, msg)
+	}
+}
+
+func handleAttachmentUploaded(ctx context.Context, msg kafkalib.Message, deps *dependencies.Dependencies) {
+	var event minioEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		deps.Logger.Error("failed to unmarshal message", slog.Any("error", err))
+		return
+	}
+	if event.EventName != "s3:ObjectCreated:Put" {
+		return
+	}
+
+	key, _ := strings.CutPrefix(event.Key, deps.S3.GetBucketName()+"/")
+	conversationId, attachmentId, ok := models.ParseAttachmentKey(key)
+	if !ok {
+		return
+	}
+
+	attachmentRepo := repository.NewAttachmentRepository(deps.Mongo, deps.Logger)
+	attachment, err := attachmentRepo.GetAttachment(ctx, conversationId, attachmentId)
+	if err != nil {
+		if errors.Is(err, repository.ErrAttachmentNotFound) {
+			deps.Logger.Warn("removing file of unknown attachment", slog.String("key", key))
+			removeObject(ctx, deps, key)
+		}
+		return
+	}
+
+	if attachment.Status != models.AttachmentStatusPending {
+		deps.Logger.Warn("rejecting attachment uploaded again", slog.String("key", key))
+		removeObject(ctx, deps, key)
+		if attachment.HasPreview {
+			removeObject(ctx, deps, attachment.PreviewKey())
+		}
+		attachment.Status = models.AttachmentStatusRejected
+		attachment.HasPreview = false
+		if err := attachmentRepo.SetAttachmentChecked(ctx, *attachment); err != nil {
+			deps.Logger.Error("failed to record attachment check", slog.String("key", key), slog.Any("error", err))
+		}
+		return
+	}
+
+	obj, err := deps.S3.GetObject(ctx, key)
+	if err != nil {
+		deps.Logger.Error("failed to get object", slog.String("key", key), slog.Any("error", err))
+		return
+	}
+	defer obj.Close()
+
+	data, err := io.ReadAll(io.LimitReader(obj, usecases.MaxAttachmentSize+1))
+	if err != nil {
+		deps.Logger.Error("failed to read object", slog.String("key", key), slog.Any("error", err))
+		return
+	}
+
+	contentType, allowed := usecases.AttachmentContentType(data)
+	attachment.Status = models.AttachmentStatusReady
+	attachment.ContentType = contentType
+	attachment.Size = int64(len(data))
+	attachment.HasPreview = false
+	if len(data) > usecases.MaxAttachmentSize || !allowed {
+		deps.Logger.Info("rejecting attachment", slog.String("key", key), slog.String("content_type", contentType), slog.Int("size", len(data)))
+		attachment.Status = models.AttachmentStatusRejected
+		removeObject(ctx, deps, key)
+	} else if strings.HasPrefix(contentType, "image/") {
+		attachment.HasPreview = putPreview(ctx, deps, *attachment, data)
+	}
+
+	if err := attachmentRepo.SetAttachmentChecked(ctx, *attachment); err != nil {
+		deps.Logger.Error("failed to record attachment check", slog.String("key", key), slog.Any("error", err))
+	}
+}
+
+func removeObject(ctx context.Context, deps *dependencies.Dependencies, key string) {
+	if err := deps.S3.RemoveObject(ctx, key); err != nil {
+		deps.Logger.Error("failed to remove object", slog.String("key", key), slog.Any("error", err))
+	}
+}
+
+// putPreview stores a JPEG preview of the image, returning whether it could. WebP images can't be decoded, so get none.
+func putPreview(ctx context.Context, deps *dependencies.Dependencies, attachment models.Attachment, data []byte) bool {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width*config.Height > previewMaxSourcePixels {
+		return false
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		deps.Logger.Warn("failed to decode image", slog.String("key", attachment.Key()), slog.Any("error", err))
+		return false
+	}
+
+	var preview bytes.Buffer
+	if err := jpeg.Encode(&preview, scaleDown(img, previewMaxDimension), &jpeg.Options{Quality: previewQuality}); err != nil {
+		deps.Logger.Error("failed to encode preview", slog.String("key", attachment.Key()), slog.Any("error", err))
+		return false
+	}
+
+	err = deps.S3.PutObject(ctx, attachment.PreviewKey(), &preview, int64(preview.Len()), "image/jpeg")
+	if err != nil {
+		deps.Logger.Error("failed to put preview", slog.String("key", attachment.PreviewKey()), slog.Any("error", err))
+		return false
+	}
+	return true
+}
+
+/*
+scaleDown fits the image within maxDimension, averaging the pixels each pixel of the result covers. Transparency is
+flattened onto white, JPEG having none.
+*/
+func scaleDown(img image.Image, maxDimension int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	scale := max(1, float64(max(width, height))/float64(maxDimension))
+	scaledWidth, scaledHeight := max(1, int(float64(width)/scale)), max(1, int(float64(height)/scale))
+
+	scaled := image.NewRGBA(image.Rect(0, 0, scaledWidth, scaledHeight))
+	for y := range scaledHeight {
+		top, bottom := bounds.Min.Y+y*height/scaledHeight, bounds.Min.Y+max((y+1)*height/scaledHeight, y*height/scaledHeight+1)
+		for x := range scaledWidth {
+			left, right := bounds.Min.X+x*width/scaledWidth, bounds.Min.X+max((x+1)*width/scaledWidth, x*width/scaledWidth+1)
+
+			var r, g, b, a, count uint64
+			for sy := top; sy < bottom; sy++ {
+				for sx := left; sx < right; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a, count = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa), count+1
+				}
+			}
+			// colors are premultiplied by alpha, so white shows through in proportion to transparency
+			white := 0xffff*count - a
+			scaled.Set(x, y, color.RGBA64{
+				R: uint16((r + white) / count),
+				G: uint16((g + white) / count),
+				B: uint16((b + white) / count),
+				A: 0xffff,
+			})
+		}
+	}
+	return scaled
+}
@@ -8,6 +8,12 @@ import (
 func GetWorkers(deps *dependencies.Dependencies) []Worker {
 	return []Worker{
 		*NewWorker("profile-image-checker", ProfileImageChecker, *deps),
+		*NewWorker("attachment-checker", AttachmentChecker, *deps),
+		*NewWorker("profile-views-aggregator", ProfileViewsAggregator, *deps),
+		*NewWorker("saved-search-matcher", SavedSearchMatcher, *deps),
+		*NewWorker("recommender", Recommender, *deps),
+		*NewWorker("message-moderator", MessageModerator, *deps),
+		*NewWorker("bio-moderator", BioModerator, *deps),
 	}
 }
 
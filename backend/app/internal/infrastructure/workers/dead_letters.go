@@ -0,0 +1,76 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	kafkalib "github.com/segmentio/kafka-go"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/infrastructure/dependencies"
+)
+
+const (
+	handleAttempts      = 5
+	handleRetryInterval = 5 * time.Second // Waited once more after every failed attempt.
+)
+
+/*
+handleWithRetries handles the message, trying again a few times while it fails, since committing it past a failure would
+leave it unhandled. A message still failing is then parked on the dead letters topic, so that it doesn't hold up the
+ones after it for good. It returns false once the context is canceled, the message being left uncommitted.
+*/
+func handleWithRetries(ctx context.Context, deps *dependencies.Dependencies, groupID string, msg kafkalib.Message, handle func() error) bool {
+	var err error
+	for attempt := range handleAttempts {
+		if attempt > 0 && !sleep(ctx, time.Duration(attempt)*handleRetryInterval) {
+			return false
+		}
+		if err = handle(); err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		deps.Logger.Warn("failed to handle message", slog.Int("attempt", attempt+1), slog.Any("error", err))
+	}
+
+	deadLetter, err := json.Marshal(events.DeadLetter{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		GroupId:   groupID,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Error:     err.Error(),
+		FailedAt:  time.Now(),
+	})
+	if err != nil {
+		deps.Logger.Error("failed to marshal dead letter", slog.Any("error", err))
+		return true
+	}
+	// the message is only committed once parked, or it would be lost
+	for {
+		err := deps.Kafka.ProduceMessage(ctx, events.DeadLettersTopic, msg.Key, deadLetter)
+		if err == nil {
+			deps.Logger.Error("parked message failing to be handled", slog.String("topic", msg.Topic), slog.Int("partition", msg.Partition), slog.Int64("offset", msg.Offset))
+			return true
+		}
+		deps.Logger.Error("failed to park message", slog.Any("error", err))
+		if !sleep(ctx, handleRetryInterval) {
+			return false
+		}
+	}
+}
+
+// sleep waits for the duration, returning false if the context is canceled first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
@@ -0,0 +1,196 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	kafkalib "github.com/segmentio/kafka-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/dependencies"
+)
+
+const (
+	messageModeratorGroupID        = "message-moderator"
+	bioModeratorGroupID            = "bio-moderator"
+	moderationRulesRefreshInterval = 30 * time.Second
+)
+
+// MessageModerator runs sent and edited messages through the moderation pipeline.
+func MessageModerator(ctx context.Context, deps *dependencies.Dependencies) {
+	consumer, err := deps.Kafka.NewConsumer(events.MessagesTopic, messageModeratorGroupID)
+	if err != nil {
+		deps.Logger.Error("failed to create consumer", slog.Any("error", err))
+		return
+	}
+	defer consumer.Close()
+
+	pipeline := newModerationPipeline(deps)
+	for {
+		msg, err := consumer.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				deps.Logger.Info("context canceled")
+				return
+			} else {
+				deps.Logger.Error("failed to read message", slog.Any("error", err))
+				continue
+			}
+		}
+		if !handleWithRetries(ctx, deps, messageModeratorGroupID, msg, func() error { return handleMessageChanged(ctx, msg, deps, pipeline) }) {
+			return
+		}
+		consumer.CommitMessages(ctx, msg)
+	}
+}
+
+// BioModerator runs the bios of registered and edited users through the moderation pipeline.
+func BioModerator(ctx context.Context, deps *dependencies.Dependencies) {
+	consumer, err := deps.Kafka.NewConsumer(events.UsersTopic, bioModeratorGroupID)
+	if err != nil {
+		deps.Logger.Error("failed to create consumer", slog.Any("error", err))
+		return
+	}
+	defer consumer.Close()
+
+	pipeline := newModerationPipeline(deps)
+	for {
+		msg, err := consumer.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				deps.Logger.Info("context canceled")
+				return
+			} else {
+				deps.Logger.Error("failed to read message", slog.Any("error", err))
+				continue
+			}
+		}
+		if !handleWithRetries(ctx, deps, bioModeratorGroupID, msg, func() error { return handleBioChanged(ctx, msg, deps, pipeline) }) {
+			return
+		}
+		consumer.CommitMessages(ctx, msg)
+	}
+}
+
+func newModerationPipeline(deps *dependencies.Dependencies) *usecases.ModerationPipeline {
+	return usecases.NewModerationPipeline(
+		repository.NewModerationRepository(deps.Mongo, deps.Logger),
+		moderationRulesRefreshInterval,
+		usecases.PatternCheck{},
+		usecases.SpamCheck{ConversationRepo: repository.NewConversationRepository(deps.Mongo, deps.Logger)},
+	)
+}
+
+// handleMessageChanged moderates the message, only failing when it may succeed on a retry.
+func handleMessageChanged(ctx context.Context, msg kafkalib.Message, deps *dependencies.Dependencies, pipeline *usecases.ModerationPipeline) error {
+	var event events.MessageChanged
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		deps.Logger.Error("failed to unmarshal message", slog.Any("error", err))
+		return nil
+	}
+	messageId, err := primitive.ObjectIDFromHex(event.MessageId)
+	if err != nil {
+		deps.Logger.Error("invalid message id", slog.String("message_id", event.MessageId))
+		return nil
+	}
+
+	conversationRepo := repository.NewConversationRepository(deps.Mongo, deps.Logger)
+	message, err := conversationRepo.FindMessage(ctx, messageId)
+	if errors.Is(err, repository.ErrMessageNotFound) {
+		deps.Logger.Warn("changed message not found", slog.String("message_id", event.MessageId))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// messages deleted meanwhile have nothing left to moderate
+	if message.DeletedAt != nil {
+		return nil
+	}
+
+	_, err = moderate(ctx, deps, pipeline, usecases.ModerationContent{
+		Subject: models.ModerationSubject{
+			Kind:           models.ModerationSubjectMessage,
+			Username:       message.Sender,
+			ConversationId: &message.ConversationId,
+			MessageId:      &message.Id,
+		},
+		Text: message.Text,
+		At:   event.ChangedAt,
+		Sent: event.Kind == events.MessageSent,
+	})
+	return err
+}
+
+/*
+handleBioChanged moderates the user's bio. A bio hidden by moderation is shown again once edited to pass, unless its
+author is shadow restricted. Failing to moderate it leaves it as it was.
+*/
+func handleBioChanged(ctx context.Context, msg kafkalib.Message, deps *dependencies.Dependencies, pipeline *usecases.ModerationPipeline) error {
+	var event events.UserChanged
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		deps.Logger.Error("failed to unmarshal message", slog.Any("error", err))
+		return nil
+	}
+
+	userRepo := repository.NewUserRepository(deps.Mongo, deps.Logger)
+	user, err := userRepo.GetUserByUsername(ctx, event.Username)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		deps.Logger.Warn("changed user not found", slog.String("username", event.Username))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	flag, err := moderate(ctx, deps, pipeline, usecases.ModerationContent{
+		Subject: models.ModerationSubject{Kind: models.ModerationSubjectBio, Username: user.Username},
+		Text:    user.Bio,
+		At:      event.ChangedAt,
+	})
+	if err != nil {
+		return err
+	}
+	if flag == nil && user.BioHidden && !user.ShadowRestricted {
+		return userRepo.SetBioHidden(ctx, user.Username, false)
+	}
+	return nil
+}
+
+/*
+moderate runs the content through the pipeline and acts on the findings, returning the flag raised if any. Rules that
+fail to compile are only reported, but failing to load the rules fails, as the content would pass unchecked.
+*/
+func moderate(ctx context.Context, deps *dependencies.Dependencies, pipeline *usecases.ModerationPipeline, content usecases.ModerationContent) (*models.ModerationFlag, error) {
+	if err := pipeline.RefreshRules(ctx); err != nil {
+		if !errors.Is(err, usecases.ErrInvalidModerationRule) {
+			return nil, fmt.Errorf("failed to load moderation rules: %w", err)
+		}
+		deps.Logger.Warn("skipped invalid moderation rules", slog.Any("error", err))
+	}
+
+	findings, err := pipeline.Moderate(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to moderate %s of %s: %w", content.Subject.Kind, content.Subject.Username, err)
+	}
+
+	userRepo := repository.NewUserRepository(deps.Mongo, deps.Logger)
+	conversationRepo := repository.NewConversationRepository(deps.Mongo, deps.Logger)
+	moderationRepo := repository.NewModerationRepository(deps.Mongo, deps.Logger)
+	flag, err := usecases.ApplyModeration(ctx, userRepo, conversationRepo, moderationRepo, content, findings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply moderation to %s of %s: %w", content.Subject.Kind, content.Subject.Username, err)
+	}
+	if flag != nil {
+		deps.Logger.Info("content flagged", slog.String("kind", string(content.Subject.Kind)), slog.String("username", content.Subject.Username), slog.String("action", string(flag.Action)))
+	}
+	return flag, nil
+}
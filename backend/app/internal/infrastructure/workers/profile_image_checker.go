@@ -14,12 +14,12 @@ import (
 )
 
 const (
-	profileImageCheckerTopic = "minio-events"
-	groupID                  = "profile-image-checker"
+	minioEventsTopic = "minio-events"
+	groupID          = "profile-image-checker"
 )
 
 func ProfileImageChecker(ctx context.Context, deps *dependencies.Dependencies) {
-	consumer, err := deps.Kafka.NewConsumer(profileImageCheckerTopic, groupID)
+	consumer, err := deps.Kafka.NewConsumer(minioEventsTopic, groupID)
 	if err != nil {
 		deps.Logger.Error("failed to create consumer", slog.Any("error", err))
 		return
@@ -56,6 +56,10 @@ func handleMessage(msg kafkalib.Message, deps *dependencies.Dependencies) {
 	}
 
 	path, _ := strings.CutPrefix(parsedMsg["Key"].(string), deps.S3.GetBucketName()+"/")
+	// the bucket also notifies of chat attachments, which have their own checker
+	if !strings.HasPrefix(path, "pfp/") {
+		return
+	}
 	obj, err := deps.S3.GetObject(context.Background(), path)
 	if err != nil {
 		deps.Logger.Error("failed to get object", slog.Any("error", err))
@@ -0,0 +1,101 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	kafkalib "github.com/segmentio/kafka-go"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/infrastructure/dependencies"
+)
+
+const (
+	profileViewsAggregatorGroupID = "profile-views-aggregator"
+)
+
+// ProfileViewsAggregator folds profile view events into the daily counters and viewer lists behind profile insights.
+func ProfileViewsAggregator(ctx context.Context, deps *dependencies.Dependencies) {
+	consumer, err := deps.Kafka.NewConsumer(events.ProfileViewsTopic, profileViewsAggregatorGroupID)
+	if err != nil {
+		deps.Logger.Error("failed to create consumer", slog.Any("error", err))
+		return
+	}
+	defer consumer.Close()
+
+	for {
+		msg, err := consumer.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				deps.Logger.Info("context canceled")
+				return
+			} else {
+				deps.Logger.Error("failed to read message", slog.Any("error", err))
+				continue
+			}
+		}
+		if !handleWithRetries(ctx, deps, profileViewsAggregatorGroupID, msg, func() error { return handleProfileViewed(ctx, msg, deps) }) {
+			return
+		}
+		consumer.CommitMessages(ctx, msg)
+	}
+}
+
+// handleProfileViewed records the view, only failing when it may succeed on a retry.
+func handleProfileViewed(ctx context.Context, msg kafkalib.Message, deps *dependencies.Dependencies) error {
+	var event events.ProfileViewed
+	err := json.Unmarshal(msg.Value, &event)
+	if err != nil {
+		deps.Logger.Error("failed to unmarshal message", slog.Any("error", err))
+		return nil
+	}
+	if event.Viewer == event.Owner {
+		return nil
+	}
+
+	userRepo := repository.NewUserRepository(deps.Mongo, deps.Logger)
+	owner, err := userRepo.GetUserByUsername(ctx, event.Owner)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		deps.Logger.Warn("profile owner not found", slog.String("owner", event.Owner))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if owner.Privacy.HideProfileViews {
+		return nil
+	}
+
+	viewer, err := userRepo.GetUserByUsername(ctx, event.Viewer)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		deps.Logger.Warn("profile viewer not found", slog.String("viewer", event.Viewer))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// views published before events had ids are told apart by their place in the topic
+	eventId := event.Id
+	if eventId == "" {
+		eventId = fmt.Sprintf("%s/%d/%d", msg.Topic, msg.Partition, msg.Offset)
+	}
+
+	viewRepo := repository.NewProfileViewRepository(deps.Mongo, deps.Logger)
+	err = viewRepo.RecordView(ctx, eventId, models.ProfileViewer{
+		Owner:        owner.Username,
+		Viewer:       viewer.Username,
+		Day:          event.ViewedAt.UTC().Format(models.ProfileViewsDayFormat),
+		LastViewedAt: event.ViewedAt,
+		Listed:       !viewer.Privacy.HideProfileViews,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record profile view: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	kafkalib "github.com/segmentio/kafka-go"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/dependencies"
+)
+
+const (
+	recommenderGroupID         = "recommender"
+	recommenderRebuildInterval = 6 * time.Hour
+	recommendationsLimit       = 20
+)
+
+/*
+Recommender computes the users to suggest to everyone. Everything is recomputed periodically from a snapshot of all
+users, and in between, registered and edited users are recomputed incrementally: their own recommendations, and
+their place in everyone else's.
+*/
+func Recommender(ctx context.Context, deps *dependencies.Dependencies) {
+	consumer, err := deps.Kafka.NewConsumer(events.UsersTopic, recommenderGroupID)
+	if err != nil {
+		deps.Logger.Error("failed to create consumer", slog.Any("error", err))
+		return
+	}
+	defer consumer.Close()
+
+	messages := make(chan kafkalib.Message)
+	go func() {
+		defer close(messages)
+		for {
+			msg, err := consumer.FetchMessage(ctx)
+			if err != nil {
+				if errors.Is(err, context.Canceled) {
+					return
+				}
+				deps.Logger.Error("failed to read message", slog.Any("error", err))
+				continue
+			}
+			select {
+			case messages <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	// the consumer must not be closed while still being read from
+	defer func() {
+		for range messages {
+		}
+	}()
+
+	model := rebuildRecommendations(ctx, deps)
+
+	ticker := time.NewTicker(recommenderRebuildInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			deps.Logger.Info("context canceled")
+			return
+		case <-ticker.C:
+			if rebuilt := rebuildRecommendations(ctx, deps); rebuilt != nil {
+				model = rebuilt
+			}
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			// without a model yet, the next rebuild covers the change
+			if model != nil {
+				updateRecommendations(ctx, msg, model, deps)
+			}
+			consumer.CommitMessages(ctx, msg)
+		}
+	}
+}
+
+// rebuildRecommendations recomputes everyone's recommendations and returns the model used, or nil on failure.
+func rebuildRecommendations(ctx context.Context, deps *dependencies.Dependencies) *usecases.RecommendationModel {
+	userRepo := repository.NewUserRepository(deps.Mongo, deps.Logger)
+	users, err := userRepo.ListUsers(ctx)
+	if err != nil {
+		deps.Logger.Error("failed to list users", slog.Any("error", err))
+		return nil
+	}
+
+	start := time.Now()
+	model := usecases.NewRecommendationModel(users)
+	recommendationRepo := repository.NewRecommendationRepository(deps.Mongo, deps.Logger)
+	for _, username := range model.Usernames() {
+		err := recommendationRepo.SaveRecommendations(ctx, models.Recommendations{
+			Owner:      username,
+			Items:      model.Recommend(username, recommendationsLimit),
+			ComputedAt: time.Now(),
+		})
+		if err != nil {
+			deps.Logger.Error("failed to save recommendations", slog.Any("error", err))
+			return nil
+		}
+	}
+
+	deps.Logger.Info("recommendations rebuilt", slog.Int("users", len(users)), slog.Duration("took", time.Since(start)))
+	return model
+}
+
+func updateRecommendations(ctx context.Context, msg kafkalib.Message, model *usecases.RecommendationModel, deps *dependencies.Dependencies) {
+	var event events.UserChanged
+	err := json.Unmarshal(msg.Value, &event)
+	if err != nil {
+		deps.Logger.Error("failed to unmarshal message", slog.Any("error", err))
+		return
+	}
+
+	userRepo := repository.NewUserRepository(deps.Mongo, deps.Logger)
+	user, err := userRepo.GetUserByUsername(ctx, event.Username)
+	if err != nil {
+		deps.Logger.Warn("failed to get changed user", slog.String("username", event.Username), slog.Any("error", err))
+		return
+	}
+	model.Update(*user)
+
+	recommendationRepo := repository.NewRecommendationRepository(deps.Mongo, deps.Logger)
+	err = recommendationRepo.SaveRecommendations(ctx, models.Recommendations{
+		Owner:      user.Username,
+		Items:      model.Recommend(user.Username, recommendationsLimit),
+		ComputedAt: time.Now(),
+	})
+	if err != nil {
+		deps.Logger.Error("failed to save recommendations", slog.Any("error", err))
+		return
+	}
+
+	byOwner := map[string]models.Recommendation{}
+	for _, owner := range model.Usernames() {
+		if recommendation, ok := model.Score(owner, user.Username); ok {
+			byOwner[owner] = recommendation
+		}
+	}
+
+	err = recommendationRepo.RemoveRecommendedUser(ctx, user.Username)
+	if err != nil {
+		deps.Logger.Error("failed to remove recommended user", slog.Any("error", err))
+		return
+	}
+	err = recommendationRepo.AddRecommendedUser(ctx, byOwner, recommendationsLimit)
+	if err != nil {
+		deps.Logger.Error("failed to add recommended user", slog.Any("error", err))
+	}
+}
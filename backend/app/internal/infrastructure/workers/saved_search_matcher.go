@@ -0,0 +1,99 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	kafkalib "github.com/segmentio/kafka-go"
+
+	"skilly/internal/domain/events"
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+	"skilly/internal/infrastructure/dependencies"
+)
+
+const (
+	savedSearchMatcherGroupID = "saved-search-matcher"
+)
+
+// SavedSearchMatcher checks registered and edited users against saved searches, so they don't have to be rerun on a timer.
+func SavedSearchMatcher(ctx context.Context, deps *dependencies.Dependencies) {
+	consumer, err := deps.Kafka.NewConsumer(events.UsersTopic, savedSearchMatcherGroupID)
+	if err != nil {
+		deps.Logger.Error("failed to create consumer", slog.Any("error", err))
+		return
+	}
+	defer consumer.Close()
+
+	for {
+		msg, err := consumer.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				deps.Logger.Info("context canceled")
+				return
+			} else {
+				deps.Logger.Error("failed to read message", slog.Any("error", err))
+				continue
+			}
+		}
+		handleUserChanged(ctx, msg, deps)
+		consumer.CommitMessages(ctx, msg)
+	}
+}
+
+func handleUserChanged(ctx context.Context, msg kafkalib.Message, deps *dependencies.Dependencies) {
+	var event events.UserChanged
+	err := json.Unmarshal(msg.Value, &event)
+	if err != nil {
+		deps.Logger.Error("failed to unmarshal message", slog.Any("error", err))
+		return
+	}
+
+	userRepo := repository.NewUserRepository(deps.Mongo, deps.Logger)
+	user, err := userRepo.GetUserByUsername(ctx, event.Username)
+	if err != nil {
+		deps.Logger.Warn("failed to get changed user", slog.String("username", event.Username), slog.Any("error", err))
+		return
+	}
+
+	blockRepo := repository.NewBlockRepository(deps.Mongo, deps.Logger)
+	savedSearchRepo := repository.NewSavedSearchRepository(deps.Mongo, deps.Logger)
+	alerted, err := usecases.MatchSavedSearches(ctx, userRepo, blockRepo, savedSearchRepo, *user, event.ChangedAt)
+	if err != nil {
+		deps.Logger.Error("failed to match saved searches", slog.Any("error", err))
+		return
+	}
+
+	for _, search := range alerted {
+		if search.Notify {
+			notifySearchAlert(ctx, deps, search, user.Username)
+		}
+	}
+}
+
+// notifySearchAlert hands the alert over for delivery to the owner of the saved search by email or push.
+func notifySearchAlert(ctx context.Context, deps *dependencies.Dependencies, search models.SavedSearch, username string) {
+	value, err := json.Marshal(events.Notification{
+		Recipient: search.Owner,
+		Kind:      events.NotificationSearchAlert,
+		Data: map[string]string{
+			"saved_search_id":   search.Id.Hex(),
+			"saved_search_name": search.Name,
+			"username":          username,
+		},
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		deps.Logger.Error("failed to marshal notification", slog.Any("error", err))
+		return
+	}
+
+	err = deps.Kafka.ProduceMessage(ctx, events.NotificationsTopic, []byte(search.Owner), value)
+	if err != nil {
+		deps.Logger.Error("failed to publish notification", slog.Any("error", err))
+	}
+}
@@ -18,6 +18,11 @@ mc event add local/skilly arn:minio:sqs::${MINIO_NOTIFY_KAFKA_ID_kafka1}:kafka \
     --event put \
     --prefix "pfp/"
 
+echo "Adding Kafka event notification for chat attachments..."
+mc event add local/skilly arn:minio:sqs::${MINIO_NOTIFY_KAFKA_ID_kafka1}:kafka \
+    --event put \
+    --prefix "chat/"
+
 echo "MinIO setup complete. Bringing MinIO process to foreground."
 # Wait for the MinIO server process to exit
 wait $MINIO_PID
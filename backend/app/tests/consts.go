@@ -2,4 +2,6 @@ package tests
 
 const (
 	Url = "http://api.localhost"
+
+	AuthCookieRegexp = "authToken=([^;]+);\\s*Path=/;\\s*Max-Age=\\d+;\\s*HttpOnly;\\s*SameSite=Strict$"
 )
\ No newline at end of file
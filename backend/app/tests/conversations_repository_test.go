@@ -0,0 +1,265 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+)
+
+func TestMessageRevisions(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_message_revisions", false)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	repo := repository.NewConversationRepository(client, logger)
+
+	conversation, err := repo.GetOrCreateConversation(ctx, "alice", "bob", nil)
+	assert.NoError(t, err)
+	message, err := repo.CreateMessage(ctx, models.Message{ConversationId: conversation.Id, Sender: "alice", Text: "helo"}, 0)
+	assert.NoError(t, err)
+
+	edited, err := repo.EditMessage(ctx, *message, "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", edited.Text)
+	assert.NotNil(t, edited.EditedAt)
+
+	deleted, err := repo.DeleteMessage(ctx, *edited)
+	assert.NoError(t, err)
+	assert.Empty(t, deleted.Text)
+	assert.NotNil(t, deleted.DeletedAt)
+
+	// tombstones can't be revised again
+	_, err = repo.EditMessage(ctx, *deleted, "hi")
+	assert.ErrorIs(t, err, repository.ErrMessageNotFound)
+
+	revisions, err := repo.GetMessageRevisions(ctx, message.Id)
+	assert.NoError(t, err)
+	if assert.Len(t, revisions, 2) {
+		assert.Equal(t, models.MessageRevisionEdit, revisions[0].Kind)
+		assert.Equal(t, "helo", revisions[0].Text)
+		assert.Equal(t, models.MessageRevisionDelete, revisions[1].Kind)
+		assert.Equal(t, "hello", revisions[1].Text)
+	}
+
+	// the conversation shows the tombstone as its last message
+	conversation, err = repo.GetConversation(ctx, conversation.Id, "bob")
+	assert.NoError(t, err)
+	assert.NotNil(t, conversation.LastMessage.DeletedAt)
+
+	assert.NoError(t, repo.HideMessage(ctx, *deleted, "bob"))
+	page, err := repo.ListMessages(ctx, conversation.Id, "bob", nil, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, page.Messages)
+	page, err = repo.ListMessages(ctx, conversation.Id, "alice", nil, 10)
+	assert.NoError(t, err)
+	assert.Len(t, page.Messages, 1)
+}
+
+func TestMessageSearch(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_message_search", false)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	repo := repository.NewConversationRepository(client, logger)
+	assert.NoError(t, repo.EnsureIndexes(ctx))
+
+	send := func(conversation *models.Conversation, sender string, text string) *models.Message {
+		message, err := repo.CreateMessage(ctx, models.Message{ConversationId: conversation.Id, Sender: sender, Text: text}, 0)
+		assert.NoError(t, err)
+		return message
+	}
+	texts := func(page *repository.MessagePage) []string {
+		result := []string{}
+		for _, message := range page.Messages {
+			result = append(result, message.Text)
+		}
+		return result
+	}
+
+	withBob, err := repo.GetOrCreateConversation(ctx, "alice", "bob", nil)
+	assert.NoError(t, err)
+	withCarol, err := repo.GetOrCreateConversation(ctx, "alice", "carol", nil)
+	assert.NoError(t, err)
+	elsewhere, err := repo.GetOrCreateConversation(ctx, "bob", "carol", nil)
+	assert.NoError(t, err)
+
+	send(withBob, "bob", "here is the link to the slides")
+	hidden := send(withBob, "bob", "another link, for later")
+	deleted := send(withBob, "alice", "a link I regret")
+	send(withCarol, "carol", "links to the recordings")
+	send(withCarol, "carol", "see you tomorrow")
+	send(elsewhere, "bob", "a link alice must not find")
+
+	assert.NoError(t, repo.HideMessage(ctx, *hidden, "alice"))
+	_, err = repo.DeleteMessage(ctx, *deleted)
+	assert.NoError(t, err)
+
+	ids, err := repo.GetConversationIds(ctx, "alice", nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []primitive.ObjectID{withBob.Id, withCarol.Id}, ids)
+
+	// stemming finds the plural too, newest first, skipping the hidden and deleted messages
+	query := repository.MessageSearchQuery{Viewer: "alice", Text: "link", ConversationIds: ids, Pagesize: 1}
+	page, err := repo.SearchMessages(ctx, query)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"links to the recordings"}, texts(page))
+	assert.True(t, page.HasMore)
+
+	query.After = &page.Next
+	page, err = repo.SearchMessages(ctx, query)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"here is the link to the slides"}, texts(page))
+	assert.False(t, page.HasMore)
+
+	// bob still finds what alice hid for herself
+	page, err = repo.SearchMessages(ctx, repository.MessageSearchQuery{Viewer: "bob", Text: "link", ConversationIds: []primitive.ObjectID{withBob.Id}, Pagesize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"another link, for later", "here is the link to the slides"}, texts(page))
+
+	page, err = repo.SearchMessages(ctx, repository.MessageSearchQuery{Viewer: "alice", Text: "link", ConversationIds: ids, Sender: "bob", Pagesize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"here is the link to the slides"}, texts(page))
+
+	future := time.Now().Add(time.Hour)
+	page, err = repo.SearchMessages(ctx, repository.MessageSearchQuery{Viewer: "alice", Text: "link", ConversationIds: ids, Since: &future, Pagesize: 10})
+	assert.NoError(t, err)
+	assert.Empty(t, page.Messages)
+}
+
+// TestConversationKindsMigrationRerun checks the conversation kinds migration can run again, as after an interruption.
+func TestConversationKindsMigrationRerun(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_conversation_kinds", false)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+
+	conversation, err := client.Database.Collection("conversations").InsertOne(ctx, bson.M{"participants": bson.A{"alice", "bob"}})
+	assert.NoError(t, err)
+
+	_, err = client.Database.Collection("migrations").DeleteOne(ctx, bson.M{"_id": 13})
+	assert.NoError(t, err)
+	assert.NoError(t, client.Migrate(ctx, repository.Migrations(), logger))
+
+	var migrated models.Conversation
+	assert.NoError(t, client.Database.Collection("conversations").FindOne(ctx, bson.M{"_id": conversation.InsertedID}).Decode(&migrated))
+	assert.Equal(t, models.ConversationKindDirect, migrated.Kind)
+}
+
+// TestMessageRequestLimit checks concurrent messages of the sender of a pending request can't go past the limit.
+func TestMessageRequestLimit(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_message_request_limit", false)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	repo := repository.NewConversationRepository(client, logger)
+
+	request := &models.MessageRequest{From: "alice", To: "bob", Status: models.MessageRequestPending, CreatedAt: time.Now()}
+	conversation, err := repo.GetOrCreateConversation(ctx, "alice", "bob", request)
+	assert.NoError(t, err)
+
+	var sent, pending atomic.Int64
+	wg := sync.WaitGroup{}
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := repo.CreateMessage(ctx, models.Message{ConversationId: conversation.Id, Sender: "alice", Text: "hello?"}, 3)
+			if err == nil {
+				sent.Add(1)
+			} else if errors.Is(err, repository.ErrRequestPending) {
+				pending.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(3), sent.Load())
+	assert.Equal(t, int64(7), pending.Load())
+
+	// the recipient isn't limited, nor are conversations that don't exist mistaken for pending requests
+	_, err = repo.CreateMessage(ctx, models.Message{ConversationId: conversation.Id, Sender: "bob", Text: "hi"}, 3)
+	assert.NoError(t, err)
+	_, err = repo.CreateMessage(ctx, models.Message{ConversationId: primitive.NewObjectID(), Sender: "alice", Text: "hi"}, 3)
+	assert.ErrorIs(t, err, repository.ErrConversationNotFound)
+}
+
+func TestRestrictedMessagesUnread(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_restricted_messages", false)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	repo := repository.NewConversationRepository(client, logger)
+
+	conversation, err := repo.GetOrCreateConversation(ctx, "alice", "bob", nil)
+	assert.NoError(t, err)
+	send := func(text string, restricted bool) *models.Message {
+		message, err := repo.CreateMessage(ctx, models.Message{ConversationId: conversation.Id, Sender: "alice", Text: text, Restricted: restricted}, 0)
+		assert.NoError(t, err)
+		return message
+	}
+	unread := func() int64 {
+		conversation, err := repo.GetConversation(ctx, conversation.Id, "bob")
+		assert.NoError(t, err)
+		return conversation.UnreadCount("bob")
+	}
+
+	hello := send("hello", false)
+	_, err = repo.MarkRead(ctx, *hello, "bob")
+	assert.NoError(t, err)
+	before, err := repo.GetConversation(ctx, conversation.Id, "bob")
+	assert.NoError(t, err)
+
+	// a restricted message changes nothing the recipient can see
+	send("buy now", true)
+	assert.Equal(t, int64(0), unread())
+	after, err := repo.GetConversation(ctx, conversation.Id, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, hello.Id, after.LastMessage.Id)
+	assert.Equal(t, before.UpdatedAt, after.UpdatedAt)
+	assert.Equal(t, hello.Id, after.ReadCursor("alice").MessageId)
+
+	// neither does restricting a message once sent, when it was the only one unread
+	sent := send("buy now!", false)
+	assert.Equal(t, int64(1), unread())
+	assert.NoError(t, repo.RestrictMessage(ctx, *sent))
+	assert.Equal(t, int64(0), unread())
+
+	// reading the last message shown reads the hidden ones after it
+	shown := send("are you there?", false)
+	send("buy now!!", true)
+	assert.Equal(t, int64(2), unread())
+	read, err := repo.MarkRead(ctx, *shown, "bob")
+	assert.NoError(t, err)
+	if assert.NotNil(t, read) {
+		assert.Equal(t, shown.Id, read.MessageId)
+	}
+	assert.Equal(t, int64(0), unread())
+}
+
+func TestEmptyGroupOwnership(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_empty_groups", false)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	repo := repository.NewGroupRepository(client, logger)
+
+	group, err := repo.CreateGroup(ctx, "alice", models.Group{Name: "Go", Skill: "go", Public: true, MemberLimit: 10})
+	assert.NoError(t, err)
+	assert.NoError(t, repo.RemoveMember(ctx, group.Id, "alice"))
+
+	// the first to join the group left empty owns it, the others joining as members
+	group, err = repo.AddMember(ctx, group.Id, "bob")
+	assert.NoError(t, err)
+	group, err = repo.AddMember(ctx, group.Id, "carol")
+	assert.NoError(t, err)
+
+	bob, _ := group.Group.Member("bob")
+	assert.Equal(t, models.GroupRoleOwner, bob.Role)
+	carol, _ := group.Group.Member("carol")
+	assert.Equal(t, models.GroupRoleMember, carol.Role)
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+)
+
+func TestModerationPipeline(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_moderation", false)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	userRepo := repository.NewUserRepository(client, logger)
+	conversationRepo := repository.NewConversationRepository(client, logger)
+	moderationRepo := repository.NewModerationRepository(client, logger)
+
+	messages := []models.ModerationSubjectKind{models.ModerationSubjectMessage}
+	_, err := client.Database.Collection("moderation_rules").InsertMany(ctx, []any{
+		models.ModerationRule{Kind: models.ModerationRuleWord, Pattern: "scam", Action: models.ModerationActionHide, Enabled: true},
+		models.ModerationRule{Kind: models.ModerationRuleEmail, Action: models.ModerationActionFlag, Enabled: true},
+		models.ModerationRule{Kind: models.ModerationRuleRate, Limit: 2, Window: 60, Subjects: messages, Action: models.ModerationActionShadowRestrict, Enabled: true},
+		models.ModerationRule{Kind: models.ModerationRuleRegex, Pattern: "(", Action: models.ModerationActionHide, Enabled: true},
+		models.ModerationRule{Kind: models.ModerationRuleWord, Pattern: "hello", Action: models.ModerationActionHide, Enabled: false},
+	})
+	assert.NoError(t, err)
+
+	pipeline := usecases.NewModerationPipeline(moderationRepo, 0, usecases.PatternCheck{}, usecases.SpamCheck{ConversationRepo: conversationRepo})
+	// the invalid regex is reported and skipped, the other rules still apply
+	assert.ErrorIs(t, pipeline.RefreshRules(ctx), usecases.ErrInvalidModerationRule)
+
+	assert.NoError(t, userRepo.CreateUser(ctx, models.User{Username: "alice", Bio: "write to alice@example.com"}))
+	conversation, err := conversationRepo.GetOrCreateConversation(ctx, "alice", "bob", nil)
+	assert.NoError(t, err)
+
+	moderate := func(content usecases.ModerationContent) *models.ModerationFlag {
+		findings, err := pipeline.Moderate(ctx, content)
+		assert.NoError(t, err)
+		flag, err := usecases.ApplyModeration(ctx, userRepo, conversationRepo, moderationRepo, content, findings)
+		assert.NoError(t, err)
+		return flag
+	}
+	send := func(text string) *models.ModerationFlag {
+		message, err := conversationRepo.CreateMessage(ctx, models.Message{ConversationId: conversation.Id, Sender: "alice", Text: text}, 0)
+		assert.NoError(t, err)
+		return moderate(usecases.ModerationContent{
+			Subject: models.ModerationSubject{
+				Kind:           models.ModerationSubjectMessage,
+				Username:       "alice",
+				ConversationId: &message.ConversationId,
+				MessageId:      &message.Id,
+			},
+			Text: message.Text,
+			At:   message.CreatedAt,
+			Sent: true,
+		})
+	}
+
+	// disabled rules don't apply, and words are matched whole
+	assert.Nil(t, send("hello, no scammers here"))
+
+	flag := send("Not a SCAM, promise")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, models.ModerationActionHide, flag.Action)
+		if assert.Len(t, flag.Findings, 1) {
+			assert.Equal(t, "SCAM", flag.Findings[0].Match)
+		}
+	}
+
+	// the restricted message is only shown to its sender
+	page, err := conversationRepo.ListMessages(ctx, conversation.Id, "bob", nil, 10)
+	assert.NoError(t, err)
+	assert.Len(t, page.Messages, 1)
+	page, err = conversationRepo.ListMessages(ctx, conversation.Id, "alice", nil, 10)
+	assert.NoError(t, err)
+	assert.Len(t, page.Messages, 2)
+
+	// the third message within a minute breaks the rate rule, the strongest action being taken
+	flag = send("mail me at alice@example.com")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, models.ModerationActionShadowRestrict, flag.Action)
+		assert.Len(t, flag.Findings, 2)
+	}
+	user, err := userRepo.GetUserByUsername(ctx, "alice")
+	assert.NoError(t, err)
+	assert.True(t, user.ShadowRestricted)
+	assert.True(t, user.BioHidden)
+
+	// rate rules only count messages, so the bio is only flagged
+	bio := usecases.ModerationContent{
+		Subject: models.ModerationSubject{Kind: models.ModerationSubjectBio, Username: "alice"},
+		Text:    user.Bio,
+		At:      time.Now(),
+	}
+	flag = moderate(bio)
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, models.ModerationActionFlag, flag.Action)
+	}
+
+	// moderating the same version again, as when retried, flags it once
+	again := moderate(bio)
+	if assert.NotNil(t, flag) && assert.NotNil(t, again) {
+		assert.Equal(t, flag.Id, again.Id)
+	}
+
+	flags, err := moderationRepo.ListFlags(ctx, 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, flags, 3) {
+		assert.Equal(t, models.ModerationSubjectBio, flags[0].Subject.Kind)
+		assert.Equal(t, "alice@example.com", flags[0].Findings[0].Match)
+	}
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+)
+
+func TestProfileViewReplay(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_profile_views", false)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	viewRepo := repository.NewProfileViewRepository(client, logger)
+
+	now := time.Now().UTC()
+	day := now.Format(models.ProfileViewsDayFormat)
+	view := func(viewer string) models.ProfileViewer {
+		return models.ProfileViewer{Owner: "alice", Viewer: viewer, Day: day, LastViewedAt: now, Listed: true}
+	}
+
+	assert.NoError(t, viewRepo.RecordView(ctx, "1", view("bob")))
+	assert.NoError(t, viewRepo.RecordView(ctx, "2", view("bob")))
+	assert.NoError(t, viewRepo.RecordView(ctx, "3", view("carol")))
+
+	// delivering the same events again counts nothing more
+	assert.NoError(t, viewRepo.RecordView(ctx, "2", view("bob")))
+	assert.NoError(t, viewRepo.RecordView(ctx, "3", view("carol")))
+
+	stats, err := viewRepo.GetDailyStats(ctx, "alice", day)
+	assert.NoError(t, err)
+	if assert.Len(t, stats, 1) {
+		assert.Equal(t, int64(3), stats[0].Views)
+		assert.Equal(t, int64(2), stats[0].UniqueViewers)
+	}
+
+	viewers, err := viewRepo.ListRecentViewers(ctx, "alice", day, nil, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, viewers, 2) {
+		views := map[string]int64{}
+		for _, viewer := range viewers {
+			views[viewer.Viewer] = viewer.Views
+		}
+		assert.Equal(t, map[string]int64{"bob": 2, "carol": 1}, views)
+	}
+}
@@ -0,0 +1,372 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	imongo "skilly/internal/adapters/mongo"
+	"skilly/internal/domain/models"
+	"skilly/internal/domain/repository"
+	"skilly/internal/domain/usecases"
+)
+
+/*
+	Repository tests and benchmarks, run against the MongoDB at TEST_MONGODB_URI (the one of the test compose file by
+	default) in databases of their own. They are skipped when it isn't reachable.
+*/
+
+const (
+	defaultTestMongoURI    = "mongodb://localhost:27017"
+	defaultBenchmarkUsers  = 1_000_000
+	benchmarkSeedBatchSize = 10_000
+)
+
+// ConnectTestMongo connects to a fresh database, or to a persistent one if keep is set so it can be seeded once.
+func ConnectTestMongo(tb testing.TB, database string, keep bool) *imongo.Client {
+	cfg := imongo.DefaultConfig()
+	cfg.URI = defaultTestMongoURI
+	if uri := os.Getenv("TEST_MONGODB_URI"); uri != "" {
+		cfg.URI = uri
+	}
+	cfg.DatabaseName = database
+	cfg.ConnectTimeout = 2 * time.Second
+	cfg.PingTimeout = time.Second
+	cfg.RetryConnectAttempts = 0
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	client, err := imongo.Connect(context.Background(), cfg, logger)
+	if err != nil {
+		tb.Skipf("MongoDB is not reachable: %v", err)
+	}
+
+	if !keep {
+		if err := client.Database.Drop(context.Background()); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	tb.Cleanup(func() {
+		if !keep {
+			client.Database.Drop(context.Background())
+		}
+		client.Disconnect(context.Background())
+	})
+
+	if err := client.Migrate(context.Background(), repository.Migrations(), logger); err != nil {
+		tb.Fatal(err)
+	}
+	return client
+}
+
+// SeedBenchmarkUsers makes sure the database has count users named user0000000, user0000001...
+func SeedBenchmarkUsers(tb testing.TB, client *imongo.Client, count int) {
+	ctx := context.Background()
+
+	existing, err := client.Database.Collection("users").CountDocuments(ctx, bson.M{})
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	skills := []string{"guitar", "piano", "go", "python", "french", "german", "chess", "cooking"}
+	for start := int(existing); start < count; start += benchmarkSeedBatchSize {
+		docs := []any{}
+		for i := start; i < min(start+benchmarkSeedBatchSize, count); i++ {
+			docs = append(docs, models.User{
+				Username:  fmt.Sprintf("user%07d", i),
+				Teaching:  []string{skills[i%len(skills)]},
+				Learning:  []string{skills[(i+3)%len(skills)]},
+				Languages: []string{},
+				Contacts:  []string{},
+				Privacy:   models.DefaultPrivacySettings(),
+				CreatedAt: time.Now(),
+			})
+		}
+		if _, err := client.Database.Collection("users").InsertMany(ctx, docs); err != nil {
+			tb.Fatal(err)
+		}
+	}
+
+	// users inserted in bulk lack the fields derived by CreateUser, which the migration deriving them fills in again
+	for _, migration := range repository.Migrations() {
+		if migration.Description == "lowercase usernames and their trigrams for username search" {
+			if err := migration.Up(ctx, client); err != nil {
+				tb.Fatal(err)
+			}
+		}
+	}
+}
+
+func benchmarkUsers(b *testing.B) int {
+	if value := os.Getenv("BENCHMARK_USERS"); value != "" {
+		count, err := strconv.Atoi(value)
+		if err != nil {
+			b.Fatal(err)
+		}
+		return count
+	}
+	return defaultBenchmarkUsers
+}
+
+func benchmarkUsernameSearch(b *testing.B, username string, infix bool) {
+	client := ConnectTestMongo(b, "skilly_benchmark", true)
+	SeedBenchmarkUsers(b, client, benchmarkUsers(b))
+	repo := repository.NewUserRepository(client, slog.Default())
+
+	query := repository.UserSearchQuery{
+		ExcludeUsername: "user0000000",
+		Username:        username,
+		UsernameInfix:   infix,
+		Pagesize:        10,
+	}
+
+	b.ResetTimer()
+	for range b.N {
+		page, err := repo.SearchUsers(context.Background(), query)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(page.Users) == 0 {
+			b.Fatal("no users found")
+		}
+	}
+}
+
+func BenchmarkSearchUsersByUsernamePrefix(b *testing.B) {
+	benchmarkUsernameSearch(b, "USER09999", false)
+}
+
+func BenchmarkSearchUsersByUsernameInfix(b *testing.B) {
+	benchmarkUsernameSearch(b, "09999", true)
+}
+
+func BenchmarkSearchUsersByUsernamePattern(b *testing.B) {
+	client := ConnectTestMongo(b, "skilly_benchmark", true)
+	SeedBenchmarkUsers(b, client, benchmarkUsers(b))
+	repo := repository.NewUserRepository(client, slog.Default())
+
+	// a pattern that would backtrack catastrophically as a regex must be matched literally, and quickly
+	query := repository.UserSearchQuery{Username: "(a+)+$", UsernameInfix: true, Pagesize: 10}
+
+	b.ResetTimer()
+	for range b.N {
+		if _, err := repo.SearchUsers(context.Background(), query); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestUsernameSearchUsesIndexes checks username searches are bounded by indexes rather than scanning every user.
+func TestUsernameSearchUsesIndexes(t *testing.T) {
+	const users = 10_000
+	client := ConnectTestMongo(t, "skilly_test_username_search", false)
+	SeedBenchmarkUsers(t, client, users)
+	repo := repository.NewUserRepository(client, slog.Default())
+
+	for expected, query := range map[int]repository.UserSearchQuery{
+		// user0000990 to user0000999
+		9: {ExcludeUsername: "user0000990", Username: "USER000099", Pagesize: 20},
+		// user0000099 too
+		10: {ExcludeUsername: "user0000990", Username: "000099", UsernameInfix: true, Pagesize: 20},
+	} {
+		before := scannedObjects(t, client)
+		page, err := repo.SearchUsers(context.Background(), query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scanned := scannedObjects(t, client) - before
+
+		if len(page.Users) != expected {
+			t.Fatalf("expected %d users for %+v, got %d", expected, query, len(page.Users))
+		}
+		for _, user := range page.Users {
+			if user.Username == query.ExcludeUsername {
+				t.Fatalf("search %+v found the searching user", query)
+			}
+		}
+		if scanned > users/10 {
+			t.Fatalf("search %+v scanned %d of %d users", query, scanned, users)
+		}
+	}
+}
+
+// TestSearchModes checks which side of the found users' skills each search mode looks at.
+func TestSearchModes(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_search_modes", false)
+	repo := repository.NewUserRepository(client, slog.Default())
+
+	searcher := models.User{Username: "searcher", Teaching: []string{"guitar"}, Learning: []string{"french"}}
+	for _, user := range []models.User{
+		searcher,
+		{Username: "exchanger", Teaching: []string{"french"}, Learning: []string{"guitar"}},
+		{Username: "teacher", Teaching: []string{"french", "chess"}, Learning: []string{"go"}},
+		{Username: "learner", Teaching: []string{"go"}, Learning: []string{"guitar", "chess"}},
+		{Username: "other", Teaching: []string{"piano"}, Learning: []string{"python"}},
+	} {
+		user.Languages = []string{}
+		user.Contacts = []string{}
+		user.Privacy = models.DefaultPrivacySettings()
+		user.CreatedAt = time.Now()
+		if err := repo.CreateUser(context.Background(), user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, test := range []struct {
+		mode     repository.UserSearchMode
+		skills   []string
+		expected []string
+	}{
+		{repository.UserSearchModeExchange, nil, []string{"exchanger", "learner"}},
+		{repository.UserSearchModeExchange, []string{"french"}, []string{"exchanger"}},
+		{repository.UserSearchModeTeachers, nil, []string{"exchanger", "teacher"}},
+		{repository.UserSearchModeTeachers, []string{"chess"}, []string{"teacher"}},
+		{repository.UserSearchModeLearners, nil, []string{"exchanger", "learner"}},
+		{repository.UserSearchModeLearners, []string{"chess"}, []string{"learner"}},
+		{repository.UserSearchModeLearners, []string{"piano"}, []string{}},
+	} {
+		learning, teaching := repository.SkillFilters(test.mode, searcher, test.skills)
+		page, err := repo.SearchUsers(context.Background(), repository.UserSearchQuery{
+			ExcludeUsername: searcher.Username,
+			Learning:        learning,
+			Teaching:        teaching,
+			Sort:            repository.UserSearchSortAlphabetical,
+			Pagesize:        10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		found := []string{}
+		for _, user := range page.Users {
+			found = append(found, user.Username)
+		}
+		if !slices.Equal(found, test.expected) {
+			t.Fatalf("expected %v searching %q in mode %s, got %v", test.expected, test.skills, test.mode, found)
+		}
+	}
+}
+
+// TestTextSearchSkipsHiddenFields checks users matching only in fields they hide are neither found nor counted.
+func TestTextSearchSkipsHiddenFields(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_text_search_hidden", false)
+	repo := repository.NewUserRepository(client, slog.Default())
+	if err := repo.EnsureIndexes(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	hideBio := models.DefaultPrivacySettings()
+	hideBio.HideBio = true
+	hideLearning := models.DefaultPrivacySettings()
+	hideLearning.HideLearning = true
+	for _, user := range []models.User{
+		{Username: "searcher"},
+		{Username: "shown", Bio: "I love painting landscapes", Privacy: models.DefaultPrivacySettings()},
+		{Username: "hiddenbio", Bio: "Painting on weekends", Privacy: hideBio},
+		{Username: "hiddenlearning", Learning: []string{"painting"}, Privacy: hideLearning},
+		{Username: "teaching", Bio: "Painting too", Teaching: []string{"painting"}, Privacy: hideBio},
+		{Username: "moderated", Bio: "Painting lessons", Privacy: models.DefaultPrivacySettings(), BioHidden: true},
+	} {
+		user.Teaching = append([]string{}, user.Teaching...)
+		user.Learning = append([]string{}, user.Learning...)
+		user.Languages = []string{}
+		user.Contacts = []string{}
+		user.CreatedAt = time.Now()
+		if err := repo.CreateUser(context.Background(), user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	query := repository.UserSearchQuery{
+		ExcludeUsername: "searcher",
+		Text:            "painting",
+		TextStems:       usecases.HighlightTerms("painting"),
+		Sort:            repository.UserSearchSortAlphabetical,
+		Pagesize:        10,
+	}
+	page, err := repo.SearchUsers(context.Background(), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := []string{}
+	for _, user := range page.Users {
+		found = append(found, user.Username)
+	}
+	if expected := []string{"shown", "teaching"}; !slices.Equal(found, expected) {
+		t.Fatalf("expected %v, got %v", expected, found)
+	}
+
+	total, err := repo.CountUsers(context.Background(), query, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 {
+		t.Fatalf("expected a total of 2, got %d", total)
+	}
+}
+
+// TestSearchCursorWithMissingSortValues checks users without a value to sort by are still reached page after page.
+func TestSearchCursorWithMissingSortValues(t *testing.T) {
+	client := ConnectTestMongo(t, "skilly_test_search_cursor_missing", false)
+	repo := repository.NewUserRepository(client, slog.Default())
+
+	now := time.Now()
+	users := client.Database.Collection("users")
+	for _, user := range []bson.M{
+		{"username": "recent", "usernamelower": "recent", "lastactiveat": now},
+		{"username": "older", "usernamelower": "older", "lastactiveat": now.Add(-time.Hour)},
+		{"username": "missing1"},
+		{"username": "null", "lastactiveat": nil},
+		{"username": "missing2"},
+	} {
+		if _, err := users.InsertOne(context.Background(), user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for sort, expected := range map[repository.UserSearchSort][]string{
+		repository.UserSearchSortRecentlyActive: {"recent", "older", "missing1", "null", "missing2"},
+		repository.UserSearchSortAlphabetical:   {"missing1", "null", "missing2", "older", "recent"},
+	} {
+		query := repository.UserSearchQuery{Sort: sort, Pagesize: 1}
+		found := []string{}
+		for {
+			page, err := repo.SearchUsers(context.Background(), query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, user := range page.Users {
+				found = append(found, user.Username)
+			}
+			if !page.HasMore {
+				break
+			}
+			query.After = &page.Next
+		}
+		if !slices.Equal(found, expected) {
+			t.Fatalf("expected %v sorting by %s, got %v", expected, sort, found)
+		}
+	}
+}
+
+// scannedObjects returns how many documents the server has examined to run queries so far.
+func scannedObjects(t *testing.T, client *imongo.Client) int64 {
+	var status struct {
+		Metrics struct {
+			QueryExecutor struct {
+				ScannedObjects int64 `bson:"scannedObjects"`
+			} `bson:"queryExecutor"`
+		} `bson:"metrics"`
+	}
+	err := client.Database.RunCommand(context.Background(), bson.D{{Key: "serverStatus", Value: 1}}).Decode(&status)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return status.Metrics.QueryExecutor.ScannedObjects
+}
@@ -1,11 +1,18 @@
 package tests
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -149,4 +156,549 @@ func GetProfilePicture(t *testing.T, httpClient *http.Client, username string) *
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 	return resp
-}
\ No newline at end of file
+}
+
+func GetPrivacySettings(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/profile/privacy")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "application/json; charset=utf-8", resp.Header.Get("Content-Type"))
+
+	return resp
+}
+
+func EditPrivacySettings(t *testing.T, httpClient *http.Client, settings map[string]any) *http.Response {
+	body := MarshalBody(t, settings)
+
+	resp, err := httpClient.Post(Url + "/profile/privacy", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func AddContact(t *testing.T, httpClient *http.Client, username string) *http.Response {
+	resp, err := httpClient.Post(Url + "/contacts/add?username=" + username, "none", bytes.NewBuffer([]byte{}))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func BlockUser(t *testing.T, httpClient *http.Client, username string) *http.Response {
+	resp, err := httpClient.Post(Url + "/users/" + username + "/block", "none", bytes.NewBuffer([]byte{}))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func UnblockUser(t *testing.T, httpClient *http.Client, username string) *http.Response {
+	resp, err := httpClient.Post(Url + "/users/" + username + "/unblock", "none", bytes.NewBuffer([]byte{}))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListBlockedUsers(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/blocks")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func FavouriteUser(t *testing.T, httpClient *http.Client, username string, favourite map[string]any) *http.Response {
+	body := MarshalBody(t, favourite)
+
+	resp, err := httpClient.Post(Url + "/users/" + username + "/favourite", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func UnfavouriteUser(t *testing.T, httpClient *http.Client, username string) *http.Response {
+	resp, err := httpClient.Post(Url + "/users/" + username + "/unfavourite", "none", bytes.NewBuffer([]byte{}))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListFavourites(t *testing.T, httpClient *http.Client, tag string) *http.Response {
+	resp, err := httpClient.Get(Url + "/favourites?tag=" + tag)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func GetProfileInsights(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/profile/insights")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func SearchUsersRaw(t *testing.T, httpClient *http.Client, bodyRaw map[string]any) *http.Response {
+	body := MarshalBody(t, bodyRaw)
+
+	resp, err := httpClient.Post(Url + "/search", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func EndorseUser(t *testing.T, httpClient *http.Client, username string, skill string, comment string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"skill":   skill,
+		"comment": comment,
+	})
+
+	resp, err := httpClient.Post(Url + "/users/" + username + "/endorse", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func UnendorseUser(t *testing.T, httpClient *http.Client, username string, skill string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"skill": skill,
+	})
+
+	resp, err := httpClient.Post(Url + "/users/" + username + "/unendorse", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListEndorsements(t *testing.T, httpClient *http.Client, username string) *http.Response {
+	resp, err := httpClient.Get(Url + "/users/" + username + "/endorsements")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func EditUserProfileRaw(t *testing.T, httpClient *http.Client, bodyRaw map[string]any) *http.Response {
+	body := MarshalBody(t, bodyRaw)
+
+	resp, err := httpClient.Post(Url + "/profile/edit", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func GetMatches(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/matches")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func SaveSearch(t *testing.T, httpClient *http.Client, name string, search map[string]any) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"name":   name,
+		"search": search,
+	})
+
+	resp, err := httpClient.Post(Url + "/saved-searches", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListSavedSearches(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/saved-searches")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func DeleteSavedSearch(t *testing.T, httpClient *http.Client, id string) *http.Response {
+	resp, err := httpClient.Post(Url + "/saved-searches/" + id + "/delete", "application/json", nil)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListAlerts(t *testing.T, httpClient *http.Client, unread bool) *http.Response {
+	resp, err := httpClient.Get(Url + "/alerts?unread=" + strconv.FormatBool(unread))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func MarkAlertsRead(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Post(Url + "/alerts/read", "application/json", nil)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func GetRecommendations(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/recommendations")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func StartConversation(t *testing.T, httpClient *http.Client, username string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"username": username,
+	})
+
+	resp, err := httpClient.Post(Url + "/conversations", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListConversations(t *testing.T, httpClient *http.Client, cursor string) *http.Response {
+	query := url.Values{}
+	if len(cursor) > 0 {
+		query.Set("cursor", cursor)
+	}
+
+	resp, err := httpClient.Get(Url + "/conversations?" + query.Encode())
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListMessageRequests(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/conversations/requests")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func RespondMessageRequest(t *testing.T, httpClient *http.Client, conversationId string, action string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"action": action,
+	})
+
+	resp, err := httpClient.Post(Url + "/conversations/" + conversationId + "/request", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func AllowMessages(t *testing.T, httpClient *http.Client, username string) *http.Response {
+	resp, err := httpClient.Post(Url + "/users/" + username + "/allow-messages", "none", bytes.NewBuffer([]byte{}))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func DisallowMessages(t *testing.T, httpClient *http.Client, username string) *http.Response {
+	resp, err := httpClient.Post(Url + "/users/" + username + "/disallow-messages", "none", bytes.NewBuffer([]byte{}))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListAllowedSenders(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/allowed-senders")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func SendMessage(t *testing.T, httpClient *http.Client, conversationId string, text string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"text": text,
+	})
+
+	resp, err := httpClient.Post(Url + "/conversations/" + conversationId + "/messages", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func SendMessageWithAttachments(t *testing.T, httpClient *http.Client, conversationId string, text string, attachmentIds []string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"text":           text,
+		"attachment_ids": attachmentIds,
+	})
+
+	resp, err := httpClient.Post(Url + "/conversations/" + conversationId + "/messages", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func EditMessage(t *testing.T, httpClient *http.Client, conversationId string, messageId string, text string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"text": text,
+	})
+
+	request, err := http.NewRequest(http.MethodPatch, Url + "/conversations/" + conversationId + "/messages/" + messageId, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(request)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func DeleteMessage(t *testing.T, httpClient *http.Client, conversationId string, messageId string, scope string) *http.Response {
+	request, err := http.NewRequest(http.MethodDelete, Url + "/conversations/" + conversationId + "/messages/" + messageId + "?scope=" + scope, nil)
+	assert.NoError(t, err)
+
+	resp, err := httpClient.Do(request)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func GetMessageHistory(t *testing.T, httpClient *http.Client, messageId string) *http.Response {
+	resp, err := httpClient.Get(Url + "/moderation/messages/" + messageId + "/history")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListModerationFlags(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/moderation/flags")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func CreateAttachment(t *testing.T, httpClient *http.Client, conversationId string, filename string, size int) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"filename": filename,
+		"size":     size,
+	})
+
+	resp, err := httpClient.Post(Url + "/conversations/" + conversationId + "/attachments", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func UploadAttachment(t *testing.T, httpClient *http.Client, uploadUrl string, blob []byte) *http.Response {
+	request, err := http.NewRequest(http.MethodPut, uploadUrl, bytes.NewReader(blob))
+	assert.NoError(t, err)
+
+	resp, err := httpClient.Do(request)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func GetAttachment(t *testing.T, httpClient *http.Client, conversationId string, attachmentId string) *http.Response {
+	resp, err := httpClient.Get(Url + "/conversations/" + conversationId + "/attachments/" + attachmentId)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+// WaitForAttachmentCheck polls the attachment until it is no longer pending, returning the last response body.
+func WaitForAttachmentCheck(t *testing.T, httpClient *http.Client, conversationId string, attachmentId string) map[string]any {
+	var respBody map[string]any
+	for range 20 {
+		resp := GetAttachment(t, httpClient, conversationId, attachmentId)
+		respBody = ParseBody(t, resp)
+		resp.Body.Close()
+		if respBody["attachment"].(map[string]interface{})["status"] != "pending" {
+			break
+		}
+		time.Sleep(time.Millisecond * 500)
+	}
+
+	return respBody
+}
+
+func WaitForAttachmentStatus(t *testing.T, httpClient *http.Client, conversationId string, attachmentId string, status string) map[string]any {
+	var respBody map[string]any
+	for range 20 {
+		resp := GetAttachment(t, httpClient, conversationId, attachmentId)
+		respBody = ParseBody(t, resp)
+		resp.Body.Close()
+		if respBody["attachment"].(map[string]interface{})["status"] == status {
+			break
+		}
+		time.Sleep(time.Millisecond * 500)
+	}
+
+	return respBody
+}
+
+func MarkRead(t *testing.T, httpClient *http.Client, conversationId string, messageId string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"message_id": messageId,
+	})
+
+	resp, err := httpClient.Post(Url + "/conversations/" + conversationId + "/read", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func SendTyping(t *testing.T, httpClient *http.Client, conversationId string) *http.Response {
+	resp, err := httpClient.Post(Url + "/conversations/" + conversationId + "/typing", "application/json", nil)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func GetUnread(t *testing.T, httpClient *http.Client) *http.Response {
+	resp, err := httpClient.Get(Url + "/conversations/unread")
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func CreateGroup(t *testing.T, httpClient *http.Client, group map[string]any) *http.Response {
+	body := MarshalBody(t, group)
+
+	resp, err := httpClient.Post(Url + "/groups", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func UpdateGroup(t *testing.T, httpClient *http.Client, groupId string, update map[string]any) *http.Response {
+	body := MarshalBody(t, update)
+
+	request, err := http.NewRequest(http.MethodPatch, Url + "/groups/" + groupId, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(request)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func CreateInvite(t *testing.T, httpClient *http.Client, groupId string) *http.Response {
+	resp, err := httpClient.Post(Url + "/groups/" + groupId + "/invites", "application/json", bytes.NewBufferString("{}"))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func JoinGroupWithInvite(t *testing.T, httpClient *http.Client, code string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"code": code,
+	})
+
+	resp, err := httpClient.Post(Url + "/groups/join", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func JoinCircle(t *testing.T, httpClient *http.Client, groupId string) *http.Response {
+	resp, err := httpClient.Post(Url + "/groups/" + groupId + "/join", "application/json", nil)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func LeaveGroup(t *testing.T, httpClient *http.Client, groupId string) *http.Response {
+	resp, err := httpClient.Post(Url + "/groups/" + groupId + "/leave", "application/json", nil)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func SetMemberRole(t *testing.T, httpClient *http.Client, groupId string, username string, role string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"role": role,
+	})
+
+	request, err := http.NewRequest(http.MethodPut, Url + "/groups/" + groupId + "/members/" + username, bytes.NewBuffer(body))
+	assert.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(request)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func RemoveMember(t *testing.T, httpClient *http.Client, groupId string, username string) *http.Response {
+	request, err := http.NewRequest(http.MethodDelete, Url + "/groups/" + groupId + "/members/" + username, nil)
+	assert.NoError(t, err)
+
+	resp, err := httpClient.Do(request)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func SearchCircles(t *testing.T, httpClient *http.Client, skills []string) *http.Response {
+	body := MarshalBody(t, map[string]any{
+		"skills": skills,
+		"mode":   "teachers",
+	})
+
+	resp, err := httpClient.Post(Url + "/groups/search", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func SearchMessages(t *testing.T, httpClient *http.Client, search map[string]any) *http.Response {
+	body := MarshalBody(t, search)
+
+	resp, err := httpClient.Post(Url + "/messages/search", "application/json", bytes.NewBuffer(body))
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ListMessages(t *testing.T, httpClient *http.Client, conversationId string, pagesize int, cursor string) *http.Response {
+	query := url.Values{"pagesize": {strconv.Itoa(pagesize)}}
+	if len(cursor) > 0 {
+		query.Set("cursor", cursor)
+	}
+
+	resp, err := httpClient.Get(Url + "/conversations/" + conversationId + "/messages?" + query.Encode())
+	assert.NoError(t, err)
+
+	return resp
+}
+
+func ConnectWebSocket(t *testing.T, httpClient *http.Client) (*websocket.Conn, *http.Response, error) {
+	dialer := websocket.Dialer{Jar: httpClient.Jar}
+	return dialer.Dial("ws" + strings.TrimPrefix(Url, "http") + "/ws", nil)
+}
+
+func OpenEventStream(t *testing.T, ctx context.Context, httpClient *http.Client, lastEventId string) *http.Response {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, Url + "/events", nil)
+	assert.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	if len(lastEventId) > 0 {
+		req.Header.Set("Last-Event-ID", lastEventId)
+	}
+
+	resp, err := httpClient.Do(req)
+	assert.NoError(t, err)
+
+	return resp
+}
+
+// ReadStreamEvent reads the next event of a Server-Sent Events stream, skipping comments and retry fields.
+func ReadStreamEvent(t *testing.T, reader *bufio.Reader) (id string, event string, data string) {
+	for {
+		line, err := reader.ReadString('\n')
+		if !assert.NoError(t, err) {
+			return
+		}
+		line = strings.TrimSuffix(line, "\n")
+
+		if len(line) == 0 {
+			if len(data) > 0 {
+				return
+			}
+			continue
+		}
+		field, value, _ := strings.Cut(line, ": ")
+		switch field {
+		case "id":
+			id = value
+		case "event":
+			event = value
+		case "data":
+			data = value
+		}
+	}
+}
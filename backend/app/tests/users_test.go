@@ -1,19 +1,21 @@
 package tests
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
+	"image"
+	"image/png"
 	"io"
 	"net/http"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
-const AuthCookieRegexp = "authToken=([^;]+);\\s*Path=/;\\s*Max-Age=\\d+;\\s*HttpOnly;\\s*SameSite=Strict$"
-
 /*
 	Utils
 */
@@ -223,6 +225,1595 @@ func TestUserFlow(t *testing.T) {
 		assert.Equal(t, 0, len(respBody["users"].([]interface{})))
 	})
 
+	t.Run("privacy-defaults", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := GetPrivacySettings(t, httpClient)
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, false, respBody["hidden_from_search"])
+		assert.Equal(t, "everyone", respBody["profile_visibility"])
+		assert.Equal(t, "everyone", respBody["picture_visibility"])
+	})
+
+	t.Run("privacy-hidden-from-search", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+
+		resp := EditPrivacySettings(t, httpClient, map[string]any{"hidden_from_search": true})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp = SearchUsers(t, httpClient, "", []string{}, -1, -1)
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 2, len(respBody["users"].([]interface{})))
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = EditPrivacySettings(t, httpClient, map[string]any{"hidden_from_search": false})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("privacy-profile-contacts-only", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+
+		resp := EditPrivacySettings(t, httpClient, map[string]any{
+			"profile_visibility": "contacts",
+			"picture_visibility": "contacts",
+			"hide_bio":           true,
+		})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp = ViewUserProfile(t, httpClient, "test0")
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "profile_restricted", respBody["code"])
+
+		resp, err = httpClient.Get(Url + "/profile/get_picture?username=test0")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp = SearchUsers(t, httpClient, "", []string{}, -1, -1)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 2, len(respBody["users"].([]interface{})))
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+
+		resp = AddContact(t, httpClient, "test")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = ViewUserProfile(t, httpClient, "test0")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "test0", respBody["username"])
+		assert.Equal(t, "", respBody["bio"])
+		assert.Equal(t, []interface{}{"testLearn1", "testLearn2"}, respBody["learning"].([]interface{}))
+
+		resp = SearchUsers(t, httpClient, "", []string{}, -1, -1)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 3, len(respBody["users"].([]interface{})))
+	})
+
+	t.Run("block-user", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp := BlockUser(t, httpClient, "test1")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListBlockedUsers(t, httpClient)
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		blocked := respBody["users"].([]interface{})
+		assert.Equal(t, 1, len(blocked))
+		assert.Equal(t, "test1", blocked[0].(map[string]interface{})["username"])
+
+		// blocked users never show up in search
+		resp = SearchUsers(t, httpClient, "", []string{}, -1, -1)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 2, len(respBody["users"].([]interface{})))
+		cancel()
+
+		// and can't see the blocker either
+		cancel, err = AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+
+		resp = ViewUserProfile(t, httpClient, "test")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "username_not_found", respBody["code"])
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = UnblockUser(t, httpClient, "test1")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = SearchUsers(t, httpClient, "", []string{}, -1, -1)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 3, len(respBody["users"].([]interface{})))
+	})
+
+	t.Run("block-self", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := BlockUser(t, httpClient, "test")
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "cannot_block_self", respBody["code"])
+	})
+
+	t.Run("favourites", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := FavouriteUser(t, httpClient, "test1", map[string]any{
+			"note": "teaches great",
+			"tags": []string{"mentor"},
+		})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = FavouriteUser(t, httpClient, "test2", map[string]any{})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListFavourites(t, httpClient, "mentor")
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		favourites := respBody["favourites"].([]interface{})
+		assert.Equal(t, 1, len(favourites))
+		favourite := favourites[0].(map[string]interface{})
+		assert.Equal(t, "teaches great", favourite["note"])
+		assert.Equal(t, "test1", favourite["user"].(map[string]interface{})["username"])
+
+		resp = SearchUsers(t, httpClient, "test1", []string{}, -1, -1)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		users := respBody["users"].([]interface{})
+		assert.Equal(t, 1, len(users))
+		assert.Equal(t, true, users[0].(map[string]interface{})["favourited"])
+
+		resp = UnfavouriteUser(t, httpClient, "test1")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListFavourites(t, httpClient, "")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		favourites = respBody["favourites"].([]interface{})
+		assert.Equal(t, 1, len(favourites))
+		assert.Equal(t, "test2", favourites[0].(map[string]interface{})["user"].(map[string]interface{})["username"])
+	})
+
+	t.Run("favourite-self", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := FavouriteUser(t, httpClient, "test", map[string]any{})
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "cannot_favourite_self", respBody["code"])
+	})
+
+	t.Run("profile-insights", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp := ViewUserProfile(t, httpClient, "test2")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test2", "testpswd")
+		assert.NoError(t, err)
+		defer cancel()
+
+		// views are aggregated asynchronously
+		assert.Eventually(t, func() bool {
+			resp := GetProfileInsights(t, httpClient)
+			defer resp.Body.Close()
+			respBody := ParseBody(t, resp)
+
+			viewers, ok := respBody["recent_viewers"].([]interface{})
+			return ok && len(viewers) == 1 && viewers[0].(map[string]interface{})["username"] == "test"
+		}, 30*time.Second, time.Second)
+
+		resp = GetProfileInsights(t, httpClient)
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		days := respBody["days"].([]interface{})
+		assert.Equal(t, 7, len(days))
+		today := days[len(days)-1].(map[string]interface{})
+		assert.Equal(t, float64(1), today["views"])
+		assert.Equal(t, float64(1), today["unique_viewers"])
+
+		resp = EditPrivacySettings(t, httpClient, map[string]any{"hide_profile_views": true})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = GetProfileInsights(t, httpClient)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "profile_views_hidden", respBody["code"])
+	})
+
+	t.Run("endorsements", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := EndorseUser(t, httpClient, "test2", "testTeach1", "great teacher")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		// endorsing twice only updates the comment
+		resp = EndorseUser(t, httpClient, "test2", "testTeach1", "really great teacher")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ViewUserProfile(t, httpClient, "test2")
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"skill": "testTeach1", "count": float64(1)},
+		}, respBody["endorsements"])
+
+		resp = ListEndorsements(t, httpClient, "test2")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		endorsements := respBody["endorsements"].([]interface{})
+		assert.Equal(t, 1, len(endorsements))
+		assert.Equal(t, "test", endorsements[0].(map[string]interface{})["endorser"])
+		assert.Equal(t, "really great teacher", endorsements[0].(map[string]interface{})["comment"])
+
+		resp = SearchUsersRaw(t, httpClient, map[string]any{"sort": "endorsements"})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, "test2", respBody["users"].([]interface{})[0].(map[string]interface{})["username"])
+
+		resp = UnendorseUser(t, httpClient, "test2", "testTeach1")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ViewUserProfile(t, httpClient, "test2")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, []interface{}{}, respBody["endorsements"])
+	})
+
+	t.Run("endorse-untaught-skill", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := EndorseUser(t, httpClient, "test2", "nonexistent", "")
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "skill_not_taught", respBody["code"])
+	})
+
+	t.Run("matches", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+
+		resp := EditUserProfileRaw(t, httpClient, map[string]any{
+			"skill_levels": []map[string]any{{"skill": "testTeach1", "level": 5}},
+		})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		// "test" teaches "testLearn1" and now learns "testTeach1", the opposite of every other user
+		resp = EditUserProfile(t, httpClient, "", "", []string{}, []string{"testTeach1"})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = GetMatches(t, httpClient)
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		matches := respBody["matches"].([]interface{})
+		assert.Equal(t, 3, len(matches))
+
+		// the expert comes first
+		best := matches[0].(map[string]interface{})
+		assert.Equal(t, "test1", best["user"].(map[string]interface{})["username"])
+		assert.Equal(t, []interface{}{"testTeach1"}, best["teaches_me"])
+		assert.Equal(t, []interface{}{"testLearn1"}, best["learns_from_me"])
+		assert.Greater(t, best["score"].(float64), matches[1].(map[string]interface{})["score"].(float64))
+	})
+
+	t.Run("skill-level-untaught-skill", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := EditUserProfileRaw(t, httpClient, map[string]any{
+			"skill_levels": []map[string]any{{"skill": "nonexistent", "level": 3}},
+		})
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "skill_not_taught", respBody["code"])
+	})
+
+	t.Run("search-full-text", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+
+		resp := EditUserProfile(t, httpClient, "", "I teach jazz piano to beginners", []string{}, []string{})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		// stemming matches "beginner" with "beginners"
+		resp = SearchUsersRaw(t, httpClient, map[string]any{"query": "piano for a beginner"})
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		users := respBody["users"].([]interface{})
+		assert.Equal(t, 1, len(users))
+		found := users[0].(map[string]interface{})
+		assert.Equal(t, "test1", found["username"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"field": "bio",
+				"text":  "I teach jazz piano to beginners",
+				"ranges": []interface{}{
+					map[string]interface{}{"start": float64(13), "end": float64(18)},
+					map[string]interface{}{"start": float64(22), "end": float64(31)},
+				},
+			},
+		}, found["highlights"])
+
+		resp = SearchUsersRaw(t, httpClient, map[string]any{"query": "saxophone"})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 0, len(respBody["users"].([]interface{})))
+	})
+
+	t.Run("search-cursor", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := SearchUsersRaw(t, httpClient, map[string]any{"pagesize": 1, "include_total": true})
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, float64(3), respBody["total"])
+
+		seen := []interface{}{}
+		for len(seen) < 3 {
+			users := respBody["users"].([]interface{})
+			assert.Equal(t, 1, len(users))
+			seen = append(seen, users[0].(map[string]interface{})["username"])
+
+			if !respBody["has_more"].(bool) {
+				break
+			}
+			resp = SearchUsersRaw(t, httpClient, map[string]any{"pagesize": 1, "cursor": respBody["next_cursor"]})
+			defer resp.Body.Close()
+			respBody = ParseBody(t, resp)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+
+		assert.ElementsMatch(t, []interface{}{"test0", "test1", "test2"}, seen)
+		assert.Equal(t, false, respBody["has_more"])
+		assert.Nil(t, respBody["next_cursor"])
+	})
+
+	t.Run("search-invalid-cursor", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := SearchUsersRaw(t, httpClient, map[string]any{"pagesize": 1})
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+		assert.Equal(t, true, respBody["has_more"])
+
+		// cursors only continue the search they were issued for
+		resp = SearchUsersRaw(t, httpClient, map[string]any{"pagesize": 1, "skills": []string{"testTeach1"}, "cursor": respBody["next_cursor"]})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "invalid_cursor", respBody["code"])
+
+		resp = SearchUsersRaw(t, httpClient, map[string]any{"cursor": "forged.cursor"})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "invalid_cursor", respBody["code"])
+	})
+
+	t.Run("search-username", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		usernames := func(resp *http.Response) []string {
+			respBody := ParseBody(t, resp)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			usernames := []string{}
+			for _, user := range respBody["users"].([]interface{}) {
+				usernames = append(usernames, user.(map[string]interface{})["username"].(string))
+			}
+			return usernames
+		}
+
+		// prefixes ignore case, and the searching user is still left out
+		resp := SearchUsersRaw(t, httpClient, map[string]any{"username": "TEST"})
+		defer resp.Body.Close()
+		found := usernames(resp)
+		assert.Contains(t, found, "test1")
+		assert.NotContains(t, found, "test")
+
+		// searches are matched literally, not as patterns
+		resp = SearchUsersRaw(t, httpClient, map[string]any{"username": "t.*"})
+		defer resp.Body.Close()
+		assert.Empty(t, usernames(resp))
+
+		resp = SearchUsersRaw(t, httpClient, map[string]any{"username": "est1"})
+		defer resp.Body.Close()
+		assert.Empty(t, usernames(resp))
+
+		resp = SearchUsersRaw(t, httpClient, map[string]any{"username": "est1", "username_infix": true})
+		defer resp.Body.Close()
+		assert.Equal(t, []string{"test1"}, usernames(resp))
+	})
+
+	t.Run("search-sort", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		search := func(body map[string]any) ([]string, map[string]interface{}) {
+			body["skills"] = []string{"testTeach1"}
+			resp := SearchUsersRaw(t, httpClient, body)
+			defer resp.Body.Close()
+			respBody := ParseBody(t, resp)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			usernames := []string{}
+			for _, user := range respBody["users"].([]interface{}) {
+				usernames = append(usernames, user.(map[string]interface{})["username"].(string))
+			}
+			return usernames, respBody
+		}
+
+		found, _ := search(map[string]any{})
+		assert.NotEmpty(t, found)
+		alphabetical := slices.Sorted(slices.Values(found))
+
+		sorted, _ := search(map[string]any{"sort": "alphabetical"})
+		assert.Equal(t, alphabetical, sorted)
+
+		// users were registered in the order of their names
+		sorted, _ = search(map[string]any{"sort": "newest"})
+		newest := slices.Clone(alphabetical)
+		slices.Reverse(newest)
+		assert.Equal(t, newest, sorted)
+
+		// pages follow the sort
+		paged := []string{}
+		body := map[string]any{"sort": "alphabetical", "pagesize": 1}
+		for {
+			page, respBody := search(body)
+			paged = append(paged, page...)
+			if respBody["has_more"] != true {
+				break
+			}
+			body = map[string]any{"sort": "alphabetical", "pagesize": 1, "cursor": respBody["next_cursor"]}
+		}
+		assert.Equal(t, alphabetical, paged)
+
+		resp := SearchUsersRaw(t, httpClient, map[string]any{"sort": "oldest"})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("search-facets", func(t *testing.T) {
+		for username, languages := range map[string][]string{
+			"test1": {"english", "german"},
+			"test2": {"english"},
+		} {
+			cancel, err := AuthorizeClient(t, httpClient, username, "testpswd")
+			assert.NoError(t, err)
+
+			resp := EditUserProfileRaw(t, httpClient, map[string]any{"languages": languages})
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			cancel()
+		}
+
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp := SearchUsersRaw(t, httpClient, map[string]any{"include_facets": true})
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		facets := respBody["facets"].(map[string]interface{})
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"value": "english", "count": float64(2)},
+			map[string]interface{}{"value": "german", "count": float64(1)},
+		}, facets["languages"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"value": "testTeach1", "count": float64(3)},
+			map[string]interface{}{"value": "testTeach2", "count": float64(3)},
+		}, facets["teaching"])
+
+		// facets follow the filters
+		resp = SearchUsersRaw(t, httpClient, map[string]any{"include_facets": true, "facet_size": 1, "languages": []string{"german"}})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+
+		users := respBody["users"].([]interface{})
+		assert.Equal(t, 1, len(users))
+		assert.Equal(t, "test1", users[0].(map[string]interface{})["username"])
+		facets = respBody["facets"].(map[string]interface{})
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"value": "english", "count": float64(1)},
+		}, facets["languages"])
+	})
+
+	t.Run("saved-search-alerts", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp := SaveSearch(t, httpClient, "french speakers", map[string]any{"languages": []string{"french"}})
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "french speakers", respBody["name"])
+		id := respBody["id"].(string)
+
+		resp = ListSavedSearches(t, httpClient)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, len(respBody["saved_searches"].([]interface{})))
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test2", "testpswd")
+		assert.NoError(t, err)
+
+		resp = EditUserProfileRaw(t, httpClient, map[string]any{"languages": []string{"english", "french"}})
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		// user changes are matched against saved searches asynchronously
+		assert.Eventually(t, func() bool {
+			resp := ListAlerts(t, httpClient, true)
+			defer resp.Body.Close()
+			respBody := ParseBody(t, resp)
+
+			alerts, ok := respBody["alerts"].([]interface{})
+			return ok && len(alerts) == 1 && alerts[0].(map[string]interface{})["username"] == "test2"
+		}, 30*time.Second, time.Second)
+
+		resp = MarkAlertsRead(t, httpClient)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListAlerts(t, httpClient, true)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 0, len(respBody["alerts"].([]interface{})))
+
+		resp = ListAlerts(t, httpClient, false)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		alerts := respBody["alerts"].([]interface{})
+		assert.Equal(t, 1, len(alerts))
+		assert.Equal(t, true, alerts[0].(map[string]interface{})["read"])
+
+		resp = DeleteSavedSearch(t, httpClient, id)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = DeleteSavedSearch(t, httpClient, id)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "saved_search_not_found", respBody["code"])
+	})
+
+	t.Run("recommendations", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		// recommendations are computed asynchronously, "test" learns "testTeach1" which every other user teaches
+		assert.Eventually(t, func() bool {
+			resp := GetRecommendations(t, httpClient)
+			defer resp.Body.Close()
+			respBody := ParseBody(t, resp)
+
+			recommendations, ok := respBody["recommendations"].([]interface{})
+			return ok && len(recommendations) > 0
+		}, 30*time.Second, time.Second)
+
+		resp := GetRecommendations(t, httpClient)
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotNil(t, respBody["computed_at"])
+		for _, recommendation := range respBody["recommendations"].([]interface{}) {
+			recommendation := recommendation.(map[string]interface{})
+			assert.NotEqual(t, "test", recommendation["user"].(map[string]interface{})["username"])
+			assert.Contains(t, recommendation["reasons"], map[string]interface{}{
+				"kind":  "teaches_your_skills",
+				"terms": []interface{}{"testTeach1"},
+			})
+		}
+	})
+
+	t.Run("register-concurrently", func(t *testing.T) {
+		const attempts = 5
+		statuses := make(chan int, attempts)
+		for range attempts {
+			go func() {
+				resp := RegisterUser(t, &http.Client{}, "concurrent", "testpswd", "concurrent", []string{"concurrency"}, []string{"parallelism"})
+				defer resp.Body.Close()
+				statuses <- resp.StatusCode
+			}()
+		}
+
+		// the unique username index lets only one of the registrations through
+		created := 0
+		for range attempts {
+			status := <-statuses
+			if status == http.StatusCreated {
+				created++
+			} else {
+				assert.Equal(t, http.StatusConflict, status)
+			}
+		}
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("chat", func(t *testing.T) {
+		// test1 takes messages from test directly, message requests being covered by chat-message-requests
+		cancel, err := AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+		resp := AllowMessages(t, httpClient, "test")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp = StartConversation(t, httpClient, "test")
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "cannot_message_self", respBody["code"])
+
+		resp = StartConversation(t, httpClient, "nonexistent")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "username_not_found", respBody["code"])
+
+		resp = StartConversation(t, httpClient, "test1")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "test1", respBody["user"].(map[string]interface{})["username"])
+		id := respBody["id"].(string)
+
+		for i := range 3 {
+			resp = SendMessage(t, httpClient, id, fmt.Sprintf("message %d", i))
+			defer resp.Body.Close()
+			respBody = ParseBody(t, resp)
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+			assert.Equal(t, "test", respBody["sender"])
+		}
+
+		resp = SendMessage(t, httpClient, id, "   ")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "empty_message", respBody["code"])
+		cancel()
+
+		// the other user gets the same conversation and sees the messages newest first
+		cancel, err = AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+
+		resp = StartConversation(t, httpClient, "test")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, id, respBody["id"])
+
+		resp = ListConversations(t, httpClient, "")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		conversations := respBody["conversations"].([]interface{})
+		assert.Equal(t, 1, len(conversations))
+		assert.Equal(t, "message 2", conversations[0].(map[string]interface{})["last_message"].(map[string]interface{})["text"])
+
+		texts := []string{}
+		cursor := ""
+		for {
+			resp = ListMessages(t, httpClient, id, 2, cursor)
+			defer resp.Body.Close()
+			respBody = ParseBody(t, resp)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			for _, message := range respBody["messages"].([]interface{}) {
+				texts = append(texts, message.(map[string]interface{})["text"].(string))
+			}
+			next, ok := respBody["next_cursor"].(string)
+			if !ok {
+				break
+			}
+			cursor = next
+		}
+		assert.Equal(t, []string{"message 2", "message 1", "message 0"}, texts)
+
+		// cursors only continue the listing they come from
+		resp = ListConversations(t, httpClient, cursor)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "invalid_cursor", respBody["code"])
+		cancel()
+
+		// outsiders and blocked users can't reach the conversation
+		cancel, err = AuthorizeClient(t, httpClient, "test2", "testpswd")
+		assert.NoError(t, err)
+
+		resp = SendMessage(t, httpClient, id, "hello")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "conversation_not_found", respBody["code"])
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = BlockUser(t, httpClient, "test1")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListMessages(t, httpClient, id, 10, "")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "conversation_not_found", respBody["code"])
+
+		resp = ListConversations(t, httpClient, "")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 0, len(respBody["conversations"].([]interface{})))
+
+		resp = UnblockUser(t, httpClient, "test1")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("chat-websocket", func(t *testing.T) {
+		_, resp, err := ConnectWebSocket(t, httpClient)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		cancel, err := AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+		ws, _, err := ConnectWebSocket(t, httpClient)
+		assert.NoError(t, err)
+		defer ws.Close()
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = StartConversation(t, httpClient, "test1")
+		defer resp.Body.Close()
+		id := ParseBody(t, resp)["id"].(string)
+
+		// the message is fanned out through Kafka, so the connection may be served by any instance
+		resp = SendMessage(t, httpClient, id, "pushed")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		ws.SetReadDeadline(time.Now().Add(30 * time.Second))
+		var event map[string]any
+		assert.NoError(t, ws.ReadJSON(&event))
+		assert.Equal(t, "message", event["type"])
+		assert.Equal(t, "pushed", event["message"].(map[string]interface{})["text"])
+		assert.Equal(t, "test", event["message"].(map[string]interface{})["sender"])
+	})
+
+	t.Run("event-stream", func(t *testing.T) {
+		ctx, cancelStreams := context.WithTimeout(context.Background(), time.Minute)
+		defer cancelStreams()
+
+		resp := OpenEventStream(t, ctx, httpClient, "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		cancel, err := AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+		test1Client := &http.Client{Jar: httpClient.Jar}
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = StartConversation(t, httpClient, "test1")
+		defer resp.Body.Close()
+		id := ParseBody(t, resp)["id"].(string)
+
+		stream := OpenEventStream(t, ctx, test1Client, "")
+		assert.Equal(t, http.StatusOK, stream.StatusCode)
+		assert.Equal(t, "text/event-stream", stream.Header.Get("Content-Type"))
+		reader := bufio.NewReader(stream.Body)
+
+		resp = SendMessage(t, httpClient, id, "streamed")
+		defer resp.Body.Close()
+
+		eventId, _, data := ReadStreamEvent(t, reader)
+		assert.NotEmpty(t, eventId)
+		assert.Contains(t, data, `"text":"streamed"`)
+		stream.Body.Close()
+
+		// the events sent while disconnected are replayed on resuming
+		resp = SendMessage(t, httpClient, id, "missed")
+		defer resp.Body.Close()
+		time.Sleep(time.Second)
+
+		stream = OpenEventStream(t, ctx, test1Client, eventId)
+		defer stream.Body.Close()
+		_, event, data := ReadStreamEvent(t, bufio.NewReader(stream.Body))
+		assert.Empty(t, event)
+		assert.Contains(t, data, `"text":"missed"`)
+
+		// unless they are gone
+		stream = OpenEventStream(t, ctx, test1Client, "unknown")
+		defer stream.Body.Close()
+		_, event, _ = ReadStreamEvent(t, bufio.NewReader(stream.Body))
+		assert.Equal(t, "reset", event)
+	})
+
+	t.Run("chat-read", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+		ws, _, err := ConnectWebSocket(t, httpClient)
+		assert.NoError(t, err)
+		defer ws.Close()
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp := StartConversation(t, httpClient, "test1")
+		defer resp.Body.Close()
+		id := ParseBody(t, resp)["id"].(string)
+
+		resp = SendMessage(t, httpClient, id, "unread")
+		defer resp.Body.Close()
+		messageId := ParseBody(t, resp)["id"].(string)
+
+		resp = SendTyping(t, httpClient, id)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		// the sender has read their own message
+		resp = ListConversations(t, httpClient, "")
+		defer resp.Body.Close()
+		conversation := ParseBody(t, resp)["conversations"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, float64(0), conversation["unread_count"])
+		cancel()
+
+		ws.SetReadDeadline(time.Now().Add(30 * time.Second))
+		types := []string{}
+		for range 2 {
+			var event map[string]any
+			assert.NoError(t, ws.ReadJSON(&event))
+			types = append(types, event["type"].(string))
+		}
+		assert.ElementsMatch(t, []string{"message", "typing"}, types)
+
+		cancel, err = AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = GetUnread(t, httpClient)
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		counts := respBody["conversations"].([]interface{})
+		assert.Equal(t, 1, len(counts))
+		assert.Equal(t, id, counts[0].(map[string]interface{})["conversation_id"])
+		assert.Equal(t, respBody["total"], counts[0].(map[string]interface{})["unread_count"])
+
+		resp = MarkRead(t, httpClient, id, "nonexistent")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "message_not_found", respBody["code"])
+
+		resp = MarkRead(t, httpClient, id, messageId)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = GetUnread(t, httpClient)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, float64(0), respBody["total"])
+		assert.Empty(t, respBody["conversations"])
+
+		resp = ListConversations(t, httpClient, "")
+		defer resp.Body.Close()
+		conversation = ParseBody(t, resp)["conversations"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, float64(0), conversation["unread_count"])
+		readers := map[string]string{}
+		for _, read := range conversation["reads"].([]interface{}) {
+			readers[read.(map[string]interface{})["username"].(string)] = read.(map[string]interface{})["message_id"].(string)
+		}
+		assert.Equal(t, map[string]string{"test": messageId, "test1": messageId}, readers)
+
+		var event map[string]any
+		assert.NoError(t, ws.ReadJSON(&event))
+		assert.Equal(t, "read", event["type"])
+		assert.Equal(t, messageId, event["read"].(map[string]interface{})["message_id"])
+
+		// messages deleted for the reader can't be marked read
+		resp = DeleteMessage(t, httpClient, id, messageId, "me")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		resp = MarkRead(t, httpClient, id, messageId)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "message_not_found", respBody["code"])
+	})
+
+	t.Run("chat-attachments", func(t *testing.T) {
+		var picture bytes.Buffer
+		assert.NoError(t, png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 640, 480))))
+
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp := StartConversation(t, httpClient, "test1")
+		defer resp.Body.Close()
+		id := ParseBody(t, resp)["id"].(string)
+
+		resp = CreateAttachment(t, httpClient, id, "worksheet.png", picture.Len())
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "pending", respBody["attachment"].(map[string]interface{})["status"])
+		attachmentId := respBody["attachment"].(map[string]interface{})["id"].(string)
+		uploadUrl := respBody["upload_url"].(string)
+
+		resp = SendMessageWithAttachments(t, httpClient, id, "", []string{attachmentId})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "attachment_not_ready", respBody["code"])
+
+		resp = UploadAttachment(t, httpClient, uploadUrl, picture.Bytes())
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		respBody = WaitForAttachmentCheck(t, httpClient, id, attachmentId)
+		attachment := respBody["attachment"].(map[string]interface{})
+		assert.Equal(t, "ready", attachment["status"])
+		assert.Equal(t, "image/png", attachment["content_type"])
+		assert.Equal(t, true, attachment["has_preview"])
+		assert.NotEmpty(t, respBody["url"])
+		assert.NotEmpty(t, respBody["preview_url"])
+		cancel()
+
+		// attachments are only found by the other participant once sent
+		cancel, err = AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+		resp = GetAttachment(t, httpClient, id, attachmentId)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "attachment_not_found", respBody["code"])
+
+		resp = SendMessageWithAttachments(t, httpClient, id, "", []string{attachmentId})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "attachment_not_found", respBody["code"])
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		resp = SendMessageWithAttachments(t, httpClient, id, "", []string{attachmentId})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, attachmentId, respBody["attachments"].([]interface{})[0].(map[string]interface{})["id"])
+		attachmentMessageId := respBody["id"].(string)
+
+		// unsupported files are deleted
+		resp = CreateAttachment(t, httpClient, id, "program.exe", 4)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		rejectedId := respBody["attachment"].(map[string]interface{})["id"].(string)
+		resp = UploadAttachment(t, httpClient, respBody["upload_url"].(string), []byte("MZ\x90\x00"))
+		defer resp.Body.Close()
+		respBody = WaitForAttachmentCheck(t, httpClient, id, rejectedId)
+		assert.Equal(t, "rejected", respBody["attachment"].(map[string]interface{})["status"])
+		assert.Nil(t, respBody["url"])
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+		defer cancel()
+		resp = GetAttachment(t, httpClient, id, attachmentId)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, err = httpClient.Get(respBody["url"].(string))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		downloaded, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, picture.Bytes(), downloaded)
+
+		// files are final once checked, so overwriting one rejects its attachment
+		resp = UploadAttachment(t, httpClient, uploadUrl, []byte("MZ\x90\x00"))
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		respBody = WaitForAttachmentStatus(t, httpClient, id, attachmentId, "rejected")
+		assert.Equal(t, "rejected", respBody["attachment"].(map[string]interface{})["status"])
+		assert.Nil(t, respBody["url"])
+		assert.Nil(t, respBody["preview_url"])
+
+		// attachments are hidden along with the messages deleted for the caller
+		resp = DeleteMessage(t, httpClient, id, attachmentMessageId, "me")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		resp = GetAttachment(t, httpClient, id, attachmentId)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "attachment_not_found", respBody["code"])
+	})
+
+	t.Run("chat-edit-delete", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp := StartConversation(t, httpClient, "test1")
+		defer resp.Body.Close()
+		id := ParseBody(t, resp)["id"].(string)
+
+		resp = SendMessage(t, httpClient, id, "helo")
+		defer resp.Body.Close()
+		messageId := ParseBody(t, resp)["id"].(string)
+
+		resp = EditMessage(t, httpClient, id, messageId, "hello")
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "hello", respBody["text"])
+		assert.NotEmpty(t, respBody["edited_at"])
+
+		// only moderators see the history
+		resp = GetMessageHistory(t, httpClient, messageId)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "not_moderator", respBody["code"])
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+
+		resp = EditMessage(t, httpClient, id, messageId, "hijacked")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "not_message_sender", respBody["code"])
+
+		resp = DeleteMessage(t, httpClient, id, messageId, "everyone")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "not_message_sender", respBody["code"])
+
+		// anyone can delete a message for themselves
+		resp = DeleteMessage(t, httpClient, id, messageId, "me")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListMessages(t, httpClient, id, 50, "")
+		defer resp.Body.Close()
+		for _, message := range ParseBody(t, resp)["messages"].([]interface{}) {
+			assert.NotEqual(t, messageId, message.(map[string]interface{})["id"])
+		}
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = DeleteMessage(t, httpClient, id, messageId, "everyone")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListMessages(t, httpClient, id, 1, "")
+		defer resp.Body.Close()
+		tombstone := ParseBody(t, resp)["messages"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, messageId, tombstone["id"])
+		assert.Equal(t, true, tombstone["deleted"])
+		assert.Equal(t, "", tombstone["text"])
+
+		resp = EditMessage(t, httpClient, id, messageId, "back")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "message_deleted", respBody["code"])
+	})
+
+	t.Run("chat-groups", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp := CreateGroup(t, httpClient, map[string]any{
+			"name":         "Spanish practice",
+			"skill":        "Spanish",
+			"public":       true,
+			"member_limit": 3,
+		})
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.Equal(t, "group", respBody["kind"])
+		assert.Nil(t, respBody["user"])
+		id := respBody["id"].(string)
+		group := respBody["group"].(map[string]interface{})
+		assert.Equal(t, "Spanish practice", group["name"])
+		assert.Equal(t, "owner", group["members"].([]interface{})[0].(map[string]interface{})["role"])
+
+		resp = SendMessage(t, httpClient, id, "¡hola a todos!")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp = CreateInvite(t, httpClient, id)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		code := respBody["code"].(string)
+		cancel()
+
+		// circles are found by skill and joined by anyone
+		cancel, err = AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+
+		resp = SearchCircles(t, httpClient, []string{"Spanish"})
+		defer resp.Body.Close()
+		found := false
+		for _, circle := range ParseBody(t, resp)["circles"].([]interface{}) {
+			found = found || circle.(map[string]interface{})["id"] == id
+		}
+		assert.True(t, found)
+
+		resp = JoinCircle(t, httpClient, id)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Len(t, respBody["group"].(map[string]interface{})["members"], 2)
+		// past messages count as read by new members
+		assert.Equal(t, float64(0), respBody["unread_count"])
+
+		resp = UpdateGroup(t, httpClient, id, map[string]any{"name": "Hijacked"})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "not_group_admin", respBody["code"])
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test2", "testpswd")
+		assert.NoError(t, err)
+
+		resp = JoinGroupWithInvite(t, httpClient, "unknown")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "invite_not_found", respBody["code"])
+
+		resp = JoinGroupWithInvite(t, httpClient, code)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp = SendMessage(t, httpClient, id, "hola")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+
+		resp = JoinCircle(t, httpClient, id)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "group_full", respBody["code"])
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = GetUnread(t, httpClient)
+		defer resp.Body.Close()
+		unread := map[string]float64{}
+		for _, count := range ParseBody(t, resp)["conversations"].([]interface{}) {
+			unread[count.(map[string]interface{})["conversation_id"].(string)] = count.(map[string]interface{})["unread_count"].(float64)
+		}
+		assert.Equal(t, float64(1), unread[id])
+
+		resp = UpdateGroup(t, httpClient, id, map[string]any{"member_limit": 2})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "member_limit_too_low", respBody["code"])
+
+		resp = LeaveGroup(t, httpClient, id)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "owner_cannot_leave", respBody["code"])
+
+		resp = RemoveMember(t, httpClient, id, "test2")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		// handing the group over makes the owner an admin, who can then leave
+		resp = SetMemberRole(t, httpClient, id, "test1", "owner")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = LeaveGroup(t, httpClient, id)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = SendMessage(t, httpClient, id, "still here?")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "conversation_not_found", respBody["code"])
+	})
+
+	t.Run("chat-message-requests", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+
+		resp := StartConversation(t, httpClient, "test2")
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "pending", respBody["request"].(map[string]interface{})["status"])
+		id := respBody["id"].(string)
+
+		resp = SendMessage(t, httpClient, id, "hi, can we talk?")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		// nothing more can be sent until the request is accepted
+		resp = SendMessage(t, httpClient, id, "hello?")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "message_request_pending", respBody["code"])
+		cancel()
+
+		// the request waits apart from the conversations of the recipient
+		cancel, err = AuthorizeClient(t, httpClient, "test2", "testpswd")
+		assert.NoError(t, err)
+
+		resp = ListMessageRequests(t, httpClient)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		requests := respBody["conversations"].([]interface{})
+		assert.Equal(t, 1, len(requests))
+		assert.Equal(t, id, requests[0].(map[string]interface{})["id"])
+		assert.Equal(t, "test0", requests[0].(map[string]interface{})["user"].(map[string]interface{})["username"])
+
+		resp = ListConversations(t, httpClient, "")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		for _, conversation := range respBody["conversations"].([]interface{}) {
+			assert.NotEqual(t, id, conversation.(map[string]interface{})["id"])
+		}
+
+		// a reply that fails to send doesn't accept the request
+		resp = SendMessageWithAttachments(t, httpClient, id, "sure", []string{"000000000000000000000000"})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "attachment_not_found", respBody["code"])
+
+		resp = ListMessageRequests(t, httpClient)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 1, len(respBody["conversations"].([]interface{})))
+
+		resp = RespondMessageRequest(t, httpClient, id, "decline")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListMessageRequests(t, httpClient)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 0, len(respBody["conversations"].([]interface{})))
+		cancel()
+
+		// the sender isn't told about the decline
+		cancel, err = AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+
+		resp = StartConversation(t, httpClient, "test2")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, "pending", respBody["request"].(map[string]interface{})["status"])
+
+		resp = RespondMessageRequest(t, httpClient, id, "accept")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "message_request_not_found", respBody["code"])
+		cancel()
+
+		// a declined request can still be accepted later
+		cancel, err = AuthorizeClient(t, httpClient, "test2", "testpswd")
+		assert.NoError(t, err)
+
+		resp = RespondMessageRequest(t, httpClient, id, "accept")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+
+		resp = SendMessage(t, httpClient, id, "hello?")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		cancel()
+
+		// allowed senders skip the request
+		cancel, err = AuthorizeClient(t, httpClient, "test2", "testpswd")
+		assert.NoError(t, err)
+
+		resp = AllowMessages(t, httpClient, "test2")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "cannot_allow_self", respBody["code"])
+
+		resp = AllowMessages(t, httpClient, "test")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListAllowedSenders(t, httpClient)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "test", respBody["users"].([]interface{})[0].(map[string]interface{})["username"])
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+
+		resp = StartConversation(t, httpClient, "test2")
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Nil(t, respBody["request"])
+		directId := respBody["id"].(string)
+
+		for i := range 2 {
+			resp = SendMessage(t, httpClient, directId, fmt.Sprintf("message %d", i))
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		}
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test2", "testpswd")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = DisallowMessages(t, httpClient, "test")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp = ListAllowedSenders(t, httpClient)
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 0, len(respBody["users"].([]interface{})))
+	})
+
+	t.Run("chat-search", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test1", "testpswd")
+		assert.NoError(t, err)
+
+		resp := StartConversation(t, httpClient, "test")
+		defer resp.Body.Close()
+		id := ParseBody(t, resp)["id"].(string)
+
+		resp = SendMessage(t, httpClient, id, "Here is the link to the slides of our lesson")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		// messages of unaccepted requests aren't found by their recipient
+		resp = StartConversation(t, httpClient, "test0")
+		defer resp.Body.Close()
+		requestId := ParseBody(t, resp)["id"].(string)
+
+		resp = SendMessage(t, httpClient, requestId, "want my slides too?")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test0", "testpswd")
+		assert.NoError(t, err)
+
+		resp = SearchMessages(t, httpClient, map[string]any{"query": "slides"})
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 0, len(respBody["results"].([]interface{})))
+
+		resp = SearchMessages(t, httpClient, map[string]any{"query": "slides", "conversation_id": id})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "conversation_not_found", respBody["code"])
+		cancel()
+
+		cancel, err = AuthorizeClient(t, httpClient, "test", "new")
+		assert.NoError(t, err)
+		defer cancel()
+
+		resp = SearchMessages(t, httpClient, map[string]any{"query": "slide", "sender": "test1", "conversation_id": id})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		results := respBody["results"].([]interface{})
+		if assert.Equal(t, 1, len(results)) {
+			result := results[0].(map[string]interface{})
+			assert.Equal(t, id, result["message"].(map[string]interface{})["conversation_id"])
+			highlight := result["highlight"].(map[string]interface{})
+			assert.Equal(t, "Here is the link to the slides of our lesson", highlight["text"])
+			assert.Equal(t, []interface{}{map[string]interface{}{"start": float64(24), "end": float64(30)}}, highlight["ranges"])
+		}
+
+		resp = SearchMessages(t, httpClient, map[string]any{"query": "slides", "sender": "test"})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, 0, len(respBody["results"].([]interface{})))
+
+		resp = SearchMessages(t, httpClient, map[string]any{"query": "   "})
+		defer resp.Body.Close()
+		respBody = ParseBody(t, resp)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "empty_query", respBody["code"])
+	})
+
+	t.Run("moderation-flags", func(t *testing.T) {
+		cancel, err := AuthorizeClient(t, httpClient, "test", "testpswd")
+		assert.NoError(t, err)
+
+		// only moderators see the flags
+		resp := ListModerationFlags(t, httpClient)
+		defer resp.Body.Close()
+		respBody := ParseBody(t, resp)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		assert.Equal(t, "not_moderator", respBody["code"])
+		cancel()
+	})
+
 	t.Run("set-profile-picture-unauthorized", func(t *testing.T) {
 		resp, err := httpClient.Post(Url+"/profile/set_picture", "application/json", bytes.NewBuffer([]byte{}))
 		assert.NoError(t, err)